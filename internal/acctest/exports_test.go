@@ -6,4 +6,21 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	NewVCRRecorder   = newVCRRecorder
+
+	VCRPlaceholderSecret  = vcrPlaceholderSecret
+	VCREscapeJSON         = vcrEscapeJSON
+	VCRRestore            = (*vcrScrubber).restore
+	VCRRestoreInteraction = (*vcrScrubber).restoreInteraction
+	VCRScrub              = (*vcrScrubber).scrub
+	VCRScrubInteraction   = (*vcrScrubber).scrubInteraction
 )
+
+func NewVCRScrubber(accountID, region, alternateRegion, thirdRegion string) *vcrScrubber {
+	return &vcrScrubber{
+		accountID:       accountID,
+		alternateRegion: alternateRegion,
+		region:          region,
+		thirdRegion:     thirdRegion,
+	}
+}
@@ -30,15 +30,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
-const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
-)
-
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
@@ -93,17 +89,17 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 }
 
 func isVCREnabled() bool {
-	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
+	return vcr.IsEnabled()
 }
 
 func vcrMode() (recorder.Mode, error) {
-	switch v := os.Getenv(envVarVCRMode); v {
-	case "RECORDING":
+	switch v := os.Getenv(vcr.EnvVarMode); v {
+	case vcr.ModeRecording:
 		return recorder.ModeRecordOnce, nil
-	case "REPLAYING":
+	case vcr.ModeReplaying:
 		return recorder.ModeReplayOnly, nil
 	default:
-		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
+		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", vcr.EnvVarMode, v)
 	}
 }
 
@@ -162,99 +158,27 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 		tlsConfig.MinVersion = tls.VersionTLS12
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		path := filepath.Join(os.Getenv(vcr.EnvVarPath), vcrFileName(testName))
+
+		// Replace account-specific values and secrets with placeholders in saved cassettes,
+		// and replace placeholders with values for the current environment in replayed responses.
+		// The account ID used when recording is only known once the provider is configured.
+		scrubber := &vcrScrubber{
+			alternateRegion: AlternateRegion(),
+			region:          Region(),
+			thirdRegion:     ThirdRegion(),
+		}
+		if vcrMode == recorder.ModeReplayOnly {
+			scrubber.accountID = vcrReplayAccountID
+		}
 
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		r, err := newVCRRecorder(ctx, path, vcrMode, httpClient.Transport, scrubber)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
-
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
@@ -273,6 +197,10 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
+		if vcrMode != recorder.ModeReplayOnly {
+			scrubber.accountID = meta.AccountID(ctx)
+		}
+
 		// Don't retry requests if a recorded interaction isn't found.
 		// TODO Need to loop through all API clients to do this.
 		// TODO Use []*client.Client?
@@ -290,6 +218,108 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// newVCRRecorder returns a VCR recorder around the specified transport.
+// Interactions are scrubbed before being saved and restored before being replayed.
+func newVCRRecorder(ctx context.Context, path string, mode recorder.Mode, realTransport http.RoundTripper, scrubber *vcrScrubber) (*recorder.Recorder, error) {
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          mode,
+		RealTransport: realTransport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive HTTP headers.
+	r.AddHook(func(i *cassette.Interaction) error {
+		delete(i.Request.Headers, "Authorization")
+		delete(i.Request.Headers, "X-Amz-Security-Token")
+
+		return nil
+	}, recorder.AfterCaptureHook)
+
+	// Replace account-specific values and secrets with placeholders in saved cassettes,
+	// and replace placeholders with values for the current environment in replayed responses.
+	r.AddHook(scrubber.scrubInteraction, recorder.BeforeSaveHook)
+	r.AddHook(scrubber.restoreInteraction, recorder.BeforeResponseReplayHook)
+
+	// Defines how VCR will match requests to responses.
+	// Requests are scrubbed before being compared to the scrubbed interactions in the cassette.
+	r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
+		// Compare method and URL first.
+		if r.Method != i.Method || scrubber.scrub(r.URL.String()) != i.URL {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := scrubber.scrub(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType := r.Header.Get("Content-Type"); contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	})
+
+	return r, nil
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -320,7 +350,7 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 			source: rand.NewSource(seed),
 		}
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(vcr.EnvVarPath), testName))
 
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
@@ -417,7 +447,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	if ok {
 		if !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(vcr.EnvVarPath), t.Name())); err != nil {
 				t.Error(err)
 			}
 		}
@@ -4,7 +4,10 @@
 package conns
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
 
@@ -27,3 +30,38 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// addRateLimiter returns a Retryer which waits for the specified rate limiter before each API call attempt.
+func addRateLimiter(r aws.Retryer, l *serviceRateLimiter) aws.RetryerV2 {
+	v, ok := r.(aws.RetryerV2)
+	if !ok {
+		v = retryerV1ToV2{Retryer: r}
+	}
+
+	return &withRateLimiter{
+		RetryerV2: v,
+		limiter:   l,
+	}
+}
+
+// retryerV1ToV2 adapts a Retryer that does not implement RetryerV2, as the AWS SDK for Go v2 does internally.
+type retryerV1ToV2 struct {
+	aws.Retryer
+}
+
+func (r retryerV1ToV2) GetAttemptToken(context.Context) (func(error) error, error) {
+	return r.GetInitialToken(), nil
+}
+
+type withRateLimiter struct {
+	aws.RetryerV2
+	limiter *serviceRateLimiter
+}
+
+func (r *withRateLimiter) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if err := r.limiter.wait(ctx, awsmiddleware.GetOperationName(ctx)); err != nil {
+		return nil, err
+	}
+
+	return r.RetryerV2.GetAttemptToken(ctx)
+}
@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	smithy "github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAddIsErrorRetryables(t *testing.T) {
@@ -93,3 +95,19 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddRateLimiter_retryerV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiters := newServiceRateLimiters(ctx, []RateLimit{
+		{Service: names.EC2, RequestsPerSecond: 100},
+	})
+
+	// A Retryer that does not implement RetryerV2.
+	r := struct{ aws.Retryer }{Retryer: retry.NewStandard()}
+
+	if _, err := addRateLimiter(r, limiters[names.EC2]).GetAttemptToken(ctx); err != nil {
+		t.Errorf("GetAttemptToken: unexpected error: %s", err)
+	}
+}
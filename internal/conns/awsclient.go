@@ -30,6 +30,7 @@ type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
 	clients                   map[string]any
+	concurrencyLimiters       map[string]*concurrencyLimiter // From service packages and provider configuration.
 	conns                     map[string]any
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
@@ -38,13 +39,16 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimiters              map[string]*serviceRateLimiter // From provider configuration.
 	region                    string
+	serviceConfigs            map[string]*serviceConfig // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagPolicyConfig           *tftags.PolicyConfig
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -71,7 +75,13 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// Any `default_tags` rules are evaluated for the resource type in Context.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(inContext.ServicePackageName, inContext.TypeName)
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -79,6 +89,15 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// TagPolicyConfig returns the provider's tag policy configuration.
+func (c *AWSClient) TagPolicyConfig(ctx context.Context) *tftags.PolicyConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.tagPolicyConfig.ForResource(inContext.ServicePackageName, inContext.TypeName)
+	}
+
+	return c.tagPolicyConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -93,17 +112,63 @@ func (c *AWSClient) Endpoints(context.Context) map[string]string {
 }
 
 // AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// This is the account of any IAM role assumed for the service package in Context, i.e. the service package of the
+// resource or data source handler, otherwise the provider's account.
+// Use AccountIDForService for the account used by another service package's API client.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if v, ok := c.serviceConfigFromContext(ctx); ok {
+		return v.accountID
+	}
+
+	return c.accountID
+}
+
+// AccountIDForService returns the AWS account ID used by the specified service package's API client.
+// This is the account of any IAM role assumed for the service package, otherwise the provider's account.
+func (c *AWSClient) AccountIDForService(_ context.Context, servicePackageName string) string {
+	if v, ok := c.serviceConfigs[servicePackageName]; ok {
+		return v.accountID
+	}
+
 	return c.accountID
 }
 
 // Partition returns the ID of the configured AWS partition.
-func (c *AWSClient) Partition(context.Context) string {
+// This is the partition of any IAM role assumed for the service package in Context, otherwise the provider's partition.
+// Use PartitionForService for the partition used by another service package's API client.
+func (c *AWSClient) Partition(ctx context.Context) string {
+	return c.partitionFromContext(ctx).ID()
+}
+
+// PartitionForService returns the ID of the AWS partition used by the specified service package's API client.
+func (c *AWSClient) PartitionForService(_ context.Context, servicePackageName string) string {
+	if v, ok := c.serviceConfigs[servicePackageName]; ok {
+		return v.partition.ID()
+	}
+
 	return c.partition.ID()
 }
 
-// Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+func (c *AWSClient) partitionFromContext(ctx context.Context) endpoints.Partition {
+	if v, ok := c.serviceConfigFromContext(ctx); ok {
+		return v.partition
+	}
+
+	return c.partition
+}
+
+// Region returns the ID of the AWS Region in effect.
+// This is the resource's `region` argument value if set, otherwise the configured AWS Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+
+	return c.region
+}
+
+// DefaultRegion returns the ID of the configured AWS Region, ignoring any per-resource override.
+func (c *AWSClient) DefaultRegion(context.Context) string {
 	return c.region
 }
 
@@ -158,6 +223,17 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	if region := c.Region(ctx); region != c.region {
+		// Clients for an overridden Region are not cached.
+		if region == endpoints.UsEast1RegionID {
+			return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -220,15 +296,15 @@ func (c *AWSClient) APIGatewayV2InvokeURL(ctx context.Context, protocolType apig
 }
 
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
-// for Amazon CloudFront distributions in the configured AWS partition.
+// for Amazon CloudFront distributions in the AWS partition used by CloudFront.
 func (c *AWSClient) CloudFrontDistributionHostedZoneID(ctx context.Context) string {
-	if c.Partition(ctx) == endpoints.AwsCnPartitionID {
+	if c.PartitionForService(ctx, names.CloudFront) == endpoints.AwsCnPartitionID {
 		return "Z3RFFRIM2A3IF5" // See https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
 	}
 	return "Z2FDTNDATAQYW2" // See https://docs.aws.amazon.com/Route53/latest/APIReference/API_AliasTarget.html#Route53-Type-AliasTarget-HostedZoneId
 }
 
-// DefaultKMSKeyPolicy returns the default policy for KMS keys in the configured AWS partition.
+// DefaultKMSKeyPolicy returns the default policy for KMS keys in the AWS account and partition used by KMS.
 func (c *AWSClient) DefaultKMSKeyPolicy(ctx context.Context) string {
 	return fmt.Sprintf(`
 {
@@ -246,7 +322,7 @@ func (c *AWSClient) DefaultKMSKeyPolicy(ctx context.Context) string {
 		}
 	]
 }	
-`, c.Partition(ctx), c.AccountID(ctx))
+`, c.PartitionForService(ctx, names.KMS), c.AccountIDForService(ctx, names.KMS))
 }
 
 // GlobalAcceleratorHostedZoneID returns the Route 53 hosted zone ID
@@ -256,10 +332,27 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
 }
 
 // DNSSuffix returns the domain suffix for the configured AWS partition.
-func (c *AWSClient) DNSSuffix(context.Context) string {
-	dnsSuffix := c.partition.DNSSuffix()
+func (c *AWSClient) DNSSuffix(ctx context.Context) string {
+	return partitionDNSSuffix(c.partitionFromContext(ctx))
+}
+
+// DNSSuffixForRegion returns the domain suffix for the AWS partition containing the specified Region.
+// It can be used where no configured AWSClient is available, e.g. in provider-defined functions.
+func DNSSuffixForRegion(region string) string {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return defaultDNSSuffix
+	}
+
+	return partitionDNSSuffix(partition)
+}
+
+const defaultDNSSuffix = "amazonaws.com"
+
+func partitionDNSSuffix(partition endpoints.Partition) string {
+	dnsSuffix := partition.DNSSuffix()
 	if dnsSuffix == "" {
-		dnsSuffix = "amazonaws.com"
+		dnsSuffix = defaultDNSSuffix
 	}
 
 	return dnsSuffix
@@ -317,10 +410,30 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig, partition := c.awsConfig, c.partition
+	// Per-service IAM role.
+	if v, ok := c.serviceConfigs[servicePackageName]; ok {
+		awsConfig, partition = v.awsConfig, v.partition
+	}
+	// Per-service rate limits.
+	if v, ok := c.rateLimiters[servicePackageName]; ok {
+		cfg := awsConfig.Copy()
+		retryer := cfg.Retryer
+		cfg.Retryer = func() aws.Retryer {
+			return addRateLimiter(retryer(), v)
+		}
+		awsConfig = &cfg
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
-		"partition":        c.Partition(ctx),
+		"partition":        partition.ID(),
+	}
+	// Per-resource Region override.
+	if region := c.Region(ctx); region != c.region {
+		cfg := awsConfig.Copy()
+		cfg.Region = region
+		m["aws_sdkv2_config"] = &cfg
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -339,12 +452,13 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty and the Region is not overridden) is cached. In this case the AWSClient lock is held.
+// Clients for an overridden Region are built per call.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
-	isDefault := len(extra) == 0
+	isDefault := len(extra) == 0 && c.Region(ctx) == c.region
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
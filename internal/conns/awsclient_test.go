@@ -5,6 +5,7 @@ package conns
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -99,6 +100,158 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1", //lintignore:AWSAT003
+			Expected:       "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), "Test", "Test", "aws_test")
+			if inContext, ok := FromContext(ctx); ok {
+				inContext.OverrideRegion = testCase.OverrideRegion
+			}
+
+			if got, want := testCase.AWSClient.Region(ctx), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+
+			if got, want := testCase.AWSClient.DefaultRegion(ctx), testCase.AWSClient.region; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientServiceAssumeRole(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		accountID: "123456789012",
+		partition: standardPartition,
+		serviceConfigs: map[string]*serviceConfig{
+			"route53": {
+				accountID: "210987654321",
+				partition: chinaPartition,
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name              string
+		Context           context.Context
+		ExpectedAccountID string
+		ExpectedPartition string
+		ExpectedDNSSuffix string
+	}{
+		{
+			Name:              "no service package",
+			Context:           context.TODO(),
+			ExpectedAccountID: "123456789012",
+			ExpectedPartition: "aws",
+			ExpectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			Name:              "other service package",
+			Context:           NewResourceContext(context.TODO(), "ec2", "VPC", "aws_vpc"),
+			ExpectedAccountID: "123456789012",
+			ExpectedPartition: "aws",
+			ExpectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			Name:              "service package with assumed role",
+			Context:           NewDataSourceContext(context.TODO(), "route53", "Hosted Zone", "aws_route53_zone"),
+			ExpectedAccountID: "210987654321",
+			ExpectedPartition: "aws-cn",
+			ExpectedDNSSuffix: "amazonaws.com.cn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := client.AccountID(testCase.Context), testCase.ExpectedAccountID; got != want {
+				t.Errorf("AccountID: got %s, expected %s", got, want)
+			}
+
+			if got, want := client.Partition(testCase.Context), testCase.ExpectedPartition; got != want {
+				t.Errorf("Partition: got %s, expected %s", got, want)
+			}
+
+			if got, want := client.DNSSuffix(testCase.Context), testCase.ExpectedDNSSuffix; got != want {
+				t.Errorf("DNSSuffix: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientServiceAssumeRoleCrossService(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		accountID: "123456789012",
+		partition: standardPartition,
+		serviceConfigs: map[string]*serviceConfig{
+			"cloudfront": {
+				accountID: "333333333333",
+				partition: chinaPartition,
+			},
+			"kms": {
+				accountID: "210987654321",
+				partition: standardPartition,
+			},
+		},
+	}
+
+	// A handler in one service package that uses another service package's account.
+	ctx := NewResourceContext(context.TODO(), "s3", "Bucket", "aws_s3_bucket")
+
+	if got, want := client.AccountID(ctx), "123456789012"; got != want {
+		t.Errorf("AccountID: got %s, expected %s", got, want)
+	}
+	if got, want := client.AccountIDForService(ctx, "kms"), "210987654321"; got != want {
+		t.Errorf("AccountIDForService(kms): got %s, expected %s", got, want)
+	}
+	if got, want := client.AccountIDForService(ctx, "s3"), "123456789012"; got != want {
+		t.Errorf("AccountIDForService(s3): got %s, expected %s", got, want)
+	}
+	if got, want := client.PartitionForService(ctx, "cloudfront"), "aws-cn"; got != want {
+		t.Errorf("PartitionForService(cloudfront): got %s, expected %s", got, want)
+	}
+	if got, want := client.DefaultKMSKeyPolicy(ctx), `"AWS": "arn:aws:iam::210987654321:root"`; !strings.Contains(got, want) {
+		t.Errorf("DefaultKMSKeyPolicy: got %s, expected to contain %s", got, want)
+	}
+	if got, want := client.CloudFrontDistributionHostedZoneID(NewResourceContext(ctx, "apigateway", "Domain Name", "aws_api_gateway_domain_name")), "Z3RFFRIM2A3IF5"; got != want {
+		t.Errorf("CloudFrontDistributionHostedZoneID: got %s, expected %s", got, want)
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -230,3 +383,39 @@ func TestReverseDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSSuffixForRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		region   string
+		expected string
+	}{
+		{
+			name:     "AWS Commercial",
+			region:   endpoints.UsWest2RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "AWS China",
+			region:   endpoints.CnNorthwest1RegionID,
+			expected: "amazonaws.com.cn",
+		},
+		{
+			name:     "AWS GovCloud (US)",
+			region:   endpoints.UsGovWest1RegionID,
+			expected: "amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := DNSSuffixForRegion(testCase.region), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
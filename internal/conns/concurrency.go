@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ConcurrencyLimit declares a named limit on the number of concurrent operations, across all resources, that count against a low AWS quota.
+type ConcurrencyLimit struct {
+	Limit int    // Default maximum number of concurrent operations. Can be overridden in provider configuration.
+	Name  string // Unique name prefixed with the service package name, e.g. "ec2.network_interface_attach".
+}
+
+// ServicePackageWithConcurrencyLimits is an interface that extends ServicePackage with named concurrency limits.
+type ServicePackageWithConcurrencyLimits interface {
+	ServicePackage
+	ConcurrencyLimits(context.Context) []ConcurrencyLimit
+}
+
+// concurrencyLimiter is a counting semaphore.
+type concurrencyLimiter struct {
+	limit int
+	name  string
+	slots chan struct{}
+}
+
+func newConcurrencyLimiter(name string, limit int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		limit: limit,
+		name:  name,
+		slots: make(chan struct{}, limit),
+	}
+}
+
+// acquire waits until the limiter has capacity or the context is done.
+func (l *concurrencyLimiter) acquire(ctx context.Context) (func(), error) {
+	ctx = tflog.SetField(ctx, "tf_aws.concurrency_limit.name", l.name)
+	ctx = tflog.SetField(ctx, "tf_aws.concurrency_limit.limit", l.limit)
+
+	start := time.Now()
+	select {
+	case l.slots <- struct{}{}:
+	default:
+		tflog.Debug(ctx, "Waiting for concurrency limit")
+
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for concurrency limit (%s): %w", l.name, ctx.Err())
+		}
+	}
+
+	tflog.Debug(ctx, "Concurrency limit acquired", map[string]any{
+		"tf_aws.concurrency_limit.wait_duration": time.Since(start).String(),
+	})
+
+	return func() {
+		<-l.slots
+	}, nil
+}
+
+// newConcurrencyLimiters returns the concurrency limiters declared by the specified service packages.
+// The overrides map limiter names to their configured limits. A limit of 0 removes the limiter.
+func newConcurrencyLimiters(ctx context.Context, servicePackages map[string]ServicePackage, overrides map[string]int) (map[string]*concurrencyLimiter, error) {
+	limits := make(map[string]int)
+	for _, sp := range servicePackages {
+		if v, ok := sp.(ServicePackageWithConcurrencyLimits); ok {
+			for _, v := range v.ConcurrencyLimits(ctx) {
+				limits[v.Name] = v.Limit
+			}
+		}
+	}
+
+	for name, limit := range overrides {
+		if _, ok := limits[name]; !ok {
+			return nil, fmt.Errorf("unknown concurrency limit: %s", name)
+		}
+		limits[name] = limit
+	}
+
+	limiters := make(map[string]*concurrencyLimiter, len(limits))
+	for name, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+
+		tflog.Debug(ctx, "Concurrency limit configured", map[string]any{
+			"tf_aws.concurrency_limit.name":  name,
+			"tf_aws.concurrency_limit.limit": limit,
+		})
+
+		limiters[name] = newConcurrencyLimiter(name, limit)
+	}
+
+	return limiters, nil
+}
+
+// AcquireConcurrency waits until the named concurrency limiter has capacity, or the Context is done,
+// and returns a function which must be called to release the capacity.
+// Pass a Context with a deadline so that the wait honors the operation's timeout.
+// Names that are not declared by any service package, or whose limit has been removed, are not limited.
+func (c *AWSClient) AcquireConcurrency(ctx context.Context, name string) (func(), error) {
+	l, ok := c.concurrencyLimiters[name]
+	if !ok {
+		return func() {}, nil
+	}
+
+	return l.acquire(ctx)
+}
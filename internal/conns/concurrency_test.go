@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAWSClientAcquireConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		concurrencyLimiters: map[string]*concurrencyLimiter{
+			"test.limited": newConcurrencyLimiter("test.limited", 2),
+		},
+	}
+
+	var releases []func()
+	for range 2 {
+		release, err := client.AcquireConcurrency(ctx, "test.limited")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		releases = append(releases, release)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.AcquireConcurrency(timeoutCtx, "test.limited"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	releases[0]()
+	release, err := client.AcquireConcurrency(ctx, "test.limited")
+	if err != nil {
+		t.Fatalf("unexpected error after release: %s", err)
+	}
+	release()
+
+	// Unknown limiters don't limit.
+	for range 3 {
+		if _, err := client.AcquireConcurrency(timeoutCtx, "test.unknown"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
+
+func TestNewConcurrencyLimiters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if _, err := newConcurrencyLimiters(ctx, nil, map[string]int{"test.unknown": 1}); err == nil {
+		t.Error("expected error for unknown concurrency limit")
+	}
+
+	limiters, err := newConcurrencyLimiters(ctx, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(limiters) != 0 {
+		t.Errorf("expected no limiters, got %d", len(limiters))
+	}
+}
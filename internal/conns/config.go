@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -31,6 +32,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -45,11 +47,13 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     []RateLimit
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceAssumeRoles             []ServiceAssumeRole
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,6 +61,8 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
+	TagPolicyOrganizationsSeverity tftags.PolicySeverity // If set, the account's effective AWS Organizations tag policy is enforced.
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -163,6 +169,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	// Structured logging and tracing of each API call.
+	cfg.APIOptions = append(cfg.APIOptions, tracing.AddAPIMiddleware)
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -207,10 +216,40 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	client.serviceConfigs = make(map[string]*serviceConfig)
+	for _, v := range c.ServiceAssumeRoles {
+		tflog.Debug(ctx, "Configuring per-service IAM role", map[string]any{
+			"tf_aws.service_assume_role.role_arn": v.AssumeRole.RoleARN,
+			"tf_aws.service_assume_role.services": v.Services,
+		})
+		serviceConfig, err := newServiceConfig(ctx, cfg, v.AssumeRole, c.Endpoints[names.STS], c.STSRegion, skipCredsValidation)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := awsbaseConfig.VerifyAccountIDAllowed(serviceConfig.accountID); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
+		}
+
+		for _, servicePackageName := range v.Services {
+			if _, ok := client.serviceConfigs[servicePackageName]; ok {
+				return nil, sdkdiag.AppendErrorf(diags, "service (%s) is specified in more than one service_assume_role block", servicePackageName)
+			}
+			client.serviceConfigs[servicePackageName] = serviceConfig
+		}
+	}
+
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	concurrencyLimiters, err := newConcurrencyLimiters(ctx, client.servicePackages, c.ConcurrencyLimits)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+	client.concurrencyLimiters = concurrencyLimiters
+	client.rateLimiters = newServiceRateLimiters(ctx, c.RateLimits)
 	client.region = c.Region
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -224,6 +263,27 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	// The effective tag policy is read once and cached for the provider's lifetime.
+	if severity := c.TagPolicyOrganizationsSeverity; severity != "" {
+		rules, err := organizationsTagPolicyRules(ctx, client, severity)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		tflog.Info(ctx, "AWS Organizations tag policy configured", map[string]any{
+			"tf_aws.tag_policy.organizations_rules": len(rules),
+		})
+
+		if len(rules) > 0 {
+			var tagPolicyConfig tftags.PolicyConfig
+			if v := c.TagPolicyConfig; v != nil {
+				tagPolicyConfig.Rules = append(tagPolicyConfig.Rules, v.Rules...)
+			}
+			tagPolicyConfig.Rules = append(tagPolicyConfig.Rules, rules...)
+			client.tagPolicyConfig = &tagPolicyConfig
+		}
+	}
+
 	return client, diags
 }
 
@@ -26,6 +26,26 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithListResources is an interface that extends ServicePackage with list resources.
+// List resources enumerate existing remote objects so that they can be imported.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
+// ServicePackageWithSDKResourceStateMovers is an interface that extends ServicePackage with Plugin SDK resource state movers.
+type ServicePackageWithSDKResourceStateMovers interface {
+	ServicePackage
+	SDKResourceStateMovers(context.Context) []SDKResourceStateMover
+}
+
+// SDKResourceStateMover declares that a `moved` block can move a resource from a legacy Plugin SDK resource type to its replacement.
+// Every attribute of the source resource type must be present, with the same type, in the target resource type.
+type SDKResourceStateMover struct {
+	SourceTypeName string // Legacy resource type name, e.g. "aws_alb"
+	TargetTypeName string // Replacement resource type name, e.g. "aws_lb"
+}
+
 type (
 	contextKeyType int
 )
@@ -38,34 +58,39 @@ var (
 type InContext struct {
 	IsDataSource        bool   // Data source?
 	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Per-resource Region override, from the resource's `region` argument
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
+	TypeName            string // Resource type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsEphemeralResource: true,
 		ResourceName:        resourceName,
 		ServicePackageName:  servicePackageName,
+		TypeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// RateLimit is a client-side limit on the rate of API calls made by a service package or by one of its operations.
+type RateLimit struct {
+	Burst             int     // Maximum number of calls made without waiting. Defaults to one second's worth of calls.
+	Operation         string  // AWS API operation name, e.g. "ChangeResourceRecordSets". If empty, the limit applies to all of the service's operations.
+	RequestsPerSecond float64 // If zero, the service's or operation's calls are not limited.
+	Service           string  // Service package name.
+}
+
+// defaultRateLimits are applied unless overridden by a provider-configured limit for the same service and operation.
+// They are well below each service's account-level API request quota.
+var defaultRateLimits = []RateLimit{
+	{Service: names.CloudFormation, RequestsPerSecond: 10},
+	{Service: names.IAM, RequestsPerSecond: 10},
+	{Service: names.Organizations, RequestsPerSecond: 5},
+	{Service: names.Route53, RequestsPerSecond: 5},
+}
+
+// serviceRateLimiter limits the rate of a service package's API calls.
+type serviceRateLimiter struct {
+	operations map[string]*rateLimiter
+	service    *rateLimiter
+}
+
+// wait blocks until the specified operation may be called.
+// An operation-specific limit takes precedence over the service's limit.
+func (l *serviceRateLimiter) wait(ctx context.Context, operation string) error {
+	if v, ok := l.operations[operation]; ok {
+		return v.wait(ctx)
+	}
+
+	return l.service.wait(ctx)
+}
+
+// newServiceRateLimiters returns rate limiters, keyed by service package name, for the specified limits merged on to the default limits.
+func newServiceRateLimiters(ctx context.Context, limits []RateLimit) map[string]*serviceRateLimiter {
+	type key struct {
+		service, operation string
+	}
+	effective := make(map[key]RateLimit)
+	for _, v := range slices.Concat(defaultRateLimits, limits) {
+		effective[key{v.Service, v.Operation}] = v
+	}
+
+	limiters := make(map[string]*serviceRateLimiter)
+	for k, v := range effective {
+		if v.RequestsPerSecond <= 0 {
+			continue
+		}
+
+		burst := v.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(v.RequestsPerSecond))
+		}
+
+		tflog.Debug(ctx, "API rate limit configured", map[string]any{
+			"tf_aws.rate_limit.service":             k.service,
+			"tf_aws.rate_limit.operation":           k.operation,
+			"tf_aws.rate_limit.requests_per_second": v.RequestsPerSecond,
+			"tf_aws.rate_limit.burst":               burst,
+		})
+
+		sl, ok := limiters[k.service]
+		if !ok {
+			sl = &serviceRateLimiter{
+				operations: make(map[string]*rateLimiter),
+			}
+			limiters[k.service] = sl
+		}
+
+		limiter := newRateLimiter(v.RequestsPerSecond, burst)
+		if k.operation == "" {
+			sl.service = limiter
+		} else {
+			sl.operations[k.operation] = limiter
+		}
+	}
+
+	return limiters
+}
+
+// rateLimiter is a token bucket rate limiter implemented using the generic cell rate algorithm.
+// A nil rateLimiter does not limit.
+type rateLimiter struct {
+	burst    time.Duration // Tolerance for calls ahead of schedule.
+	interval time.Duration // Emission interval between calls.
+	lock     sync.Mutex
+	tat      time.Time // Theoretical arrival time of the next call.
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	interval := time.Duration(float64(time.Second) / requestsPerSecond)
+
+	return &rateLimiter{
+		burst:    time.Duration(burst-1) * interval,
+		interval: interval,
+	}
+}
+
+// reserve reserves the next call and returns how long the caller must wait before making it.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.tat.Before(now) {
+		l.tat = now
+	}
+
+	delay := l.tat.Sub(now) - l.burst
+	l.tat = l.tat.Add(l.interval)
+
+	return max(delay, 0)
+}
+
+// wait blocks until the next call may be made or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	delay := l.reserve(time.Now())
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
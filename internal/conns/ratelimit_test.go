@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(5, 2)
+	now := time.Now()
+
+	// The burst is available immediately, then calls are spaced by the emission interval.
+	for i, want := range []time.Duration{0, 0, 200 * time.Millisecond, 400 * time.Millisecond} {
+		if got := limiter.reserve(now); got != want {
+			t.Errorf("call %d: got delay %s, want %s", i, got, want)
+		}
+	}
+
+	// Unused capacity accrues up to the burst.
+	now = now.Add(10 * time.Second)
+	for i, want := range []time.Duration{0, 0, 200 * time.Millisecond} {
+		if got := limiter.reserve(now); got != want {
+			t.Errorf("call %d after idle: got delay %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestNewServiceRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiters := newServiceRateLimiters(ctx, []RateLimit{
+		{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 1},
+		{Service: names.IAM, RequestsPerSecond: 0},
+		{Service: names.EC2, RequestsPerSecond: 50, Burst: 100},
+	})
+
+	if _, ok := limiters[names.IAM]; ok {
+		t.Errorf("expected no limiter for %s", names.IAM)
+	}
+
+	if v, ok := limiters[names.Route53]; !ok {
+		t.Errorf("expected limiter for %s", names.Route53)
+	} else {
+		if v.service == nil {
+			t.Errorf("expected default limit for %s", names.Route53)
+		}
+		if got, want := v.operations["ChangeResourceRecordSets"].interval, time.Second; got != want {
+			t.Errorf("%s operation interval: got %s, want %s", names.Route53, got, want)
+		}
+	}
+
+	if v, ok := limiters[names.EC2]; !ok {
+		t.Errorf("expected limiter for %s", names.EC2)
+	} else if got, want := v.service.burst, 99*20*time.Millisecond; got != want {
+		t.Errorf("%s burst tolerance: got %s, want %s", names.EC2, got, want)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// ServiceAssumeRole is an IAM role assumed for API calls made by the specified service packages.
+// The role is assumed using the provider's credentials, i.e. after any provider-level `assume_role` chain.
+type ServiceAssumeRole struct {
+	AssumeRole awsbase.AssumeRole
+	Services   []string // Service package names.
+}
+
+// serviceConfig is the per-service package configuration resulting from a ServiceAssumeRole.
+type serviceConfig struct {
+	accountID string
+	awsConfig *aws.Config
+	partition endpoints.Partition
+}
+
+// newServiceConfig returns a copy of the specified AWS SDK for Go v2 configuration that assumes the specified IAM role.
+// The account ID and partition are taken from the role's ARN.
+func newServiceConfig(ctx context.Context, cfg aws.Config, ar awsbase.AssumeRole, stsEndpoint, stsRegion string, skipCredsValidation bool) (*serviceConfig, error) {
+	roleARN, err := arn.Parse(ar.RoleARN)
+	if err != nil {
+		return nil, fmt.Errorf("parsing IAM role ARN (%s): %w", ar.RoleARN, err)
+	}
+
+	var partition endpoints.Partition
+	for _, v := range endpoints.DefaultPartitions() {
+		if v.ID() == roleARN.Partition {
+			partition = v
+			break
+		}
+	}
+
+	stsClient := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if stsEndpoint != "" {
+			o.BaseEndpoint = aws.String(stsEndpoint)
+		}
+		if stsRegion != "" {
+			o.Region = stsRegion
+		}
+	})
+	provider := stscreds.NewAssumeRoleProvider(stsClient, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		if ar.Duration != 0 {
+			o.Duration = ar.Duration
+		}
+		if ar.ExternalID != "" {
+			o.ExternalID = aws.String(ar.ExternalID)
+		}
+		if ar.Policy != "" {
+			o.Policy = aws.String(ar.Policy)
+		}
+		if len(ar.PolicyARNs) > 0 {
+			o.PolicyARNs = tfslices.ApplyToAll(ar.PolicyARNs, func(v string) ststypes.PolicyDescriptorType {
+				return ststypes.PolicyDescriptorType{
+					Arn: aws.String(v),
+				}
+			})
+		}
+		if ar.SessionName != "" {
+			o.RoleSessionName = ar.SessionName
+		}
+		if ar.SourceIdentity != "" {
+			o.SourceIdentity = aws.String(ar.SourceIdentity)
+		}
+		for k, v := range ar.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+		if len(ar.TransitiveTagKeys) > 0 {
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+		}
+	})
+
+	cfg = cfg.Copy()
+	cfg.Credentials = aws.NewCredentialsCache(provider)
+
+	if !skipCredsValidation {
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM role (%s): %w", ar.RoleARN, err)
+		}
+	}
+
+	return &serviceConfig{
+		accountID: roleARN.AccountID,
+		awsConfig: &cfg,
+		partition: partition,
+	}, nil
+}
+
+// serviceConfigFromContext returns any per-service package configuration for the service package in Context.
+func (c *AWSClient) serviceConfigFromContext(ctx context.Context) (*serviceConfig, bool) {
+	if len(c.serviceConfigs) == 0 {
+		return nil, false
+	}
+
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	v, ok := c.serviceConfigs[inContext.ServicePackageName]
+	return v, ok
+}
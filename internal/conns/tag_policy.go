@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// organizationsTagPolicyRules returns tag policy rules for the account's effective AWS Organizations tag policy.
+// No rules are returned if no tag policy applies to the account.
+func organizationsTagPolicyRules(ctx context.Context, c *AWSClient, severity tftags.PolicySeverity) ([]tftags.PolicyRule, error) {
+	conn, err := client[*organizations.Client](ctx, c, names.Organizations, make(map[string]any))
+	if err != nil {
+		return nil, err
+	}
+
+	input := organizations.DescribeEffectivePolicyInput{
+		PolicyType: awstypes.EffectivePolicyTypeTagPolicy,
+	}
+	output, err := conn.DescribeEffectivePolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS Organizations effective tag policy: %w", err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return tftags.OrganizationsPolicyRules(aws.ToString(output.EffectivePolicy.PolicyContent), severity)
+}
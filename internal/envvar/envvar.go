@@ -84,6 +84,27 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for limiting resource sweepers
+const (
+	// Whether to list the resources that would be swept without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated resource name prefixes. Only resources whose names (or IDs) have one of the prefixes are swept
+	SweepIncludeNamePrefixes = "TF_AWS_SWEEP_INCLUDE_NAME_PREFIXES"
+
+	// Comma-separated resource name prefixes. Resources whose names (or IDs) have one of the prefixes are not swept
+	SweepExcludeNamePrefixes = "TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES"
+
+	// Comma-separated tags, either `key` or `key=value`. Only resources with one of the tags are swept
+	SweepIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+
+	// Comma-separated tags, either `key` or `key=value`. Resources with one of the tags are not swept
+	SweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// Minimum resource age, e.g. `6h`. Only resources known to have been created at least this long ago are swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
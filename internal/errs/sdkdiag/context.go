@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// contextWarnings holds the warnings recorded in a Context.
+type contextWarnings struct {
+	mu    sync.Mutex
+	diags diag.Diagnostics
+}
+
+// NewWarningsContext returns a Context in which warnings can be recorded by functions that cannot return them,
+// such as CustomizeDiff functions, and a function that returns the recorded warnings.
+func NewWarningsContext(ctx context.Context) (context.Context, func() diag.Diagnostics) {
+	v := &contextWarnings{}
+
+	return context.WithValue(ctx, warningsKey, v), func() diag.Diagnostics {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+
+		return slices.Clone(v.diags)
+	}
+}
+
+// AppendContextWarningf records a warning in the specified Context.
+// It returns whether the warning was recorded, i.e. whether the Context was returned by NewWarningsContext.
+func AppendContextWarningf(ctx context.Context, format string, a ...any) bool {
+	v, ok := ctx.Value(warningsKey).(*contextWarnings)
+	if !ok {
+		return false
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.diags = AppendWarningf(v.diags, format, a...)
+
+	return true
+}
+
+type warningsKeyType int
+
+var warningsKey warningsKeyType
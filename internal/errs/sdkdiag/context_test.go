@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendContextWarningf(t *testing.T) {
+	t.Parallel()
+
+	if sdkdiag.AppendContextWarningf(context.Background(), "warning") {
+		t.Error("AppendContextWarningf without warnings Context = true, want false")
+	}
+
+	ctx, warnings := sdkdiag.NewWarningsContext(context.Background())
+
+	if got := warnings(); len(got) != 0 {
+		t.Errorf("warnings = %v, want none", got)
+	}
+
+	if !sdkdiag.AppendContextWarningf(ctx, "warning %d", 1) {
+		t.Error("AppendContextWarningf = false, want true")
+	}
+	derived, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if !sdkdiag.AppendContextWarningf(derived, "warning %d", 2) {
+		t.Error("AppendContextWarningf with derived Context = false, want true")
+	}
+
+	got := warnings()
+	want := diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "warning 1"},
+		{Severity: diag.Warning, Summary: "warning 2"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("warnings = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !sdkdiag.Comparer(got[i], want[i]) {
+			t.Errorf("warnings[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -11,8 +11,9 @@ import (
 	testing "github.com/mitchellh/go-testing-interface"
 )
 
-// Semaphore can be used to limit concurrent executions.
-// This can be used to work with resources with low quotas.
+// Semaphore can be used to limit concurrent acceptance test executions.
+// This can be used to test resources with low quotas.
+// Provider code should instead use named concurrency limits, see conns.AWSClient.AcquireConcurrency.
 type Semaphore chan struct{}
 
 var semaphoreKV = &struct {
@@ -24,7 +25,7 @@ var semaphoreKV = &struct {
 }
 
 // GetSemaphore returns a named semaphore with a default capacity or overrides it using an environment variable
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// NOTE: for use in acceptance tests only.
 func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 	semaphoreKV.lock.Lock()
 	defer semaphoreKV.lock.Unlock()
@@ -46,13 +47,13 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 }
 
 // Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// NOTE: for use in acceptance tests only.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// NOTE: for use in acceptance tests only.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -63,7 +64,7 @@ func (s Semaphore) Notify() {
 }
 
 // TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// NOTE: for use in acceptance tests only.
 func TestAccPreCheckSyncronize(t testing.T, semaphore Semaphore, resource string) {
 	if cap(semaphore) == 0 {
 		t.Skipf("concurrency for %s testing set to 0", resource)
@@ -197,6 +197,7 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -240,6 +241,7 @@ func (expander autoExpander) bool(ctx context.Context, vFrom basetypes.BoolValua
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -297,6 +299,7 @@ func (expander autoExpander) float64(ctx context.Context, vFrom basetypes.Float6
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -340,6 +343,7 @@ func (expander autoExpander) float32(ctx context.Context, vFrom basetypes.Float3
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "Expanding incompatible types")
 	diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(vFrom), vTo.Type()))
 	return diags
@@ -394,6 +398,7 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -437,6 +442,7 @@ func (expander autoExpander) int32(ctx context.Context, vFrom basetypes.Int32Val
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "Expanding incompatible types")
 	diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(vFrom), vTo.Type()))
 	return diags
@@ -528,6 +534,7 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -578,6 +585,7 @@ func (expander autoExpander) object(ctx context.Context, sourcePath path.Path, v
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -612,6 +620,7 @@ func (expander autoExpander) list(ctx context.Context, sourcePath path.Path, vFr
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from list[%s]": v.ElementType(ctx),
 		"to":            vTo.Kind(),
@@ -684,6 +693,7 @@ func (expander autoExpander) listOrSetOfInt64(ctx context.Context, vFrom valueWi
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -742,6 +752,7 @@ func (expander autoExpander) listOrSetOfString(ctx context.Context, vFrom valueW
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
@@ -814,6 +825,7 @@ func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuab
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from map[string, %s]": v.ElementType(ctx),
 		"to":                   vTo.Kind(),
@@ -869,6 +881,7 @@ func (expander autoExpander) mapOfString(ctx context.Context, vFrom basetypes.Ma
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from map[string, %s]": vFrom.ElementType(ctx),
 		"to":                   vTo.Kind(),
@@ -903,6 +916,7 @@ func (expander autoExpander) set(ctx context.Context, sourcePath path.Path, vFro
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
 		"from set[%s]": v.ElementType(ctx),
 		"to":           vTo.Kind(),
@@ -1003,6 +1017,12 @@ func (expander autoExpander) nestedObjectToStruct(ctx context.Context, sourcePat
 		return diags
 	}
 
+	// Map populated fields to an AWS API union member.
+	if expander.isUnionSource(from, tStruct) {
+		diags.Append(expander.union(ctx, sourcePath, from, targetPath, tStruct, vTo)...)
+		return diags
+	}
+
 	// Create a new target structure and walk its fields.
 	to := reflect.New(tStruct)
 	if !reflect.ValueOf(from).IsNil() {
@@ -1049,6 +1069,16 @@ func (expander autoExpander) nestedObjectCollectionToSlice(ctx context.Context,
 		targetPath := targetPath.AtListIndex(i)
 		ctx := tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
 		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+
+		// Map populated fields to an AWS API union member.
+		if from := f.Index(i).Interface(); expander.isUnionSource(from, tElem) {
+			diags.Append(expander.union(ctx, sourcePath, from, targetPath, tElem, t.Index(i))...)
+			if diags.HasError() {
+				return diags
+			}
+			continue
+		}
+
 		// Create a new target structure and walk its fields.
 		target := reflect.New(tElem)
 		diags.Append(autoFlexConvertStruct(ctx, sourcePath, f.Index(i).Interface(), targetPath, target.Interface(), expander)...)
@@ -1071,6 +1101,74 @@ func (expander autoExpander) nestedObjectCollectionToSlice(ctx context.Context,
 	return diags
 }
 
+// isUnionSource returns true if the nested object `from` is to be mapped to a member of union type tUnion.
+// Sources that implement flex.Expander or flex.TypedExpander customize their own expansion.
+func (expander autoExpander) isUnionSource(from any, tUnion reflect.Type) bool {
+	if reflect.ValueOf(from).IsNil() || !expander.Options.isUnion(tUnion) {
+		return false
+	}
+
+	switch from.(type) {
+	case Expander, TypedExpander:
+		return false
+	}
+
+	return true
+}
+
+// union copies a Plugin Framework nested object, with at most one populated field, to a compatible AWS API union member value.
+func (expander autoExpander) union(ctx context.Context, sourcePath path.Path, from any, targetPath path.Path, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valFrom := reflect.ValueOf(from).Elem()
+	typeFrom := valFrom.Type()
+
+	var member reflect.Value
+	for i := 0; i < typeFrom.NumField(); i++ {
+		field := typeFrom.Field(i)
+		if !field.IsExported() {
+			continue // Skip unexported fields.
+		}
+
+		fieldName := field.Name
+		if v, ok := valFrom.Field(i).Interface().(attr.Value); !ok || !isUnionMemberSet(v) {
+			continue
+		}
+
+		tMember, ok := expander.Options.unionMemberType(tUnion, fieldName)
+		if !ok {
+			tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+				logAttrKeySourceFieldname: fieldName,
+			})
+			diags.Append(diagExpandingNoUnionMember(typeFrom, fieldName, tUnion))
+			return diags
+		}
+
+		if member.IsValid() {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set")
+			diags.Append(diagExpandingMultipleUnionMembers(typeFrom, tUnion))
+			return diags
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fieldName,
+			logAttrKeyTargetFieldname: tMember.Elem().Name(),
+		})
+
+		member = reflect.New(tMember.Elem())
+		diags.Append(expander.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath, member.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if member.IsValid() {
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, sourcePath path.Path, vFrom fwtypes.NestedObjectCollectionValue, targetPath path.Path, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1320,6 +1418,26 @@ func diagExpandingNoMapBlockKey(sourceType reflect.Type) diag.ErrorDiagnostic {
 	)
 }
 
+func diagExpandingMultipleUnionMembers(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q sets more than one member of union type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q field %q has no corresponding member of union type %q.", fullTypeName(sourceType), sourceFieldName, fullTypeName(targetType)),
+	)
+}
+
 func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -4607,6 +4607,153 @@ func TestExpandIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandFieldNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"tag name": {
+			Source: tfFieldNameStructTag{
+				Field1:    types.StringValue("value1"),
+				KmsKeyArn: types.StringValue("value2"),
+			},
+			Target: &awsFieldNameStructTag{},
+			WantTarget: &awsFieldNameStructTag{
+				Field1:   aws.String("value1"),
+				KMSKeyID: aws.String("value2"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfFieldNameStructTag](), reflect.TypeFor[*awsFieldNameStructTag]()),
+				infoConverting(reflect.TypeFor[tfFieldNameStructTag](), reflect.TypeFor[*awsFieldNameStructTag]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfFieldNameStructTag](), "Field1", reflect.TypeFor[*awsFieldNameStructTag]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				traceMatchedFields("KmsKeyArn", reflect.TypeFor[tfFieldNameStructTag](), "KMSKeyID", reflect.TypeFor[*awsFieldNameStructTag]()),
+				infoConvertingWithPath("KmsKeyArn", reflect.TypeFor[types.String](), "KMSKeyID", reflect.TypeFor[*string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandErrorOnUnmatchedFields(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1"`
+		Field2 types.String `tfsdk:"field2"`
+		Field3 types.String `tfsdk:"field3" autoflex:"-"`
+	}
+	type aws01 struct {
+		Field1 *string
+	}
+
+	testCases := autoFlexTestCases{
+		"unmatched field": {
+			Source: tf01{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+				Field3: types.StringValue("value3"),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				Field1: aws.String("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01]()),
+				infoConverting(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tf01](), "Field1", reflect.TypeFor[*aws01]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				debugNoCorrespondingField(reflect.TypeFor[tf01](), "Field2", reflect.TypeFor[*aws01]()),
+				traceSkipIgnoredSourceField(reflect.TypeFor[tf01](), "Field3", reflect.TypeFor[*aws01]()),
+			},
+		},
+		"unmatched field with option": {
+			Options: []AutoFlexOptionsFunc{WithErrorOnUnmatchedFields()},
+			Source: tf01{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+				Field3: types.StringValue("value3"),
+			},
+			Target: &aws01{},
+			expectedDiags: diag.Diagnostics{
+				diagConvertingUnmatchedField(reflect.TypeFor[tf01](), "Field2", reflect.TypeFor[aws01]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01]()),
+				infoConverting(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tf01](), "Field1", reflect.TypeFor[*aws01]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				debugNoCorrespondingField(reflect.TypeFor[tf01](), "Field2", reflect.TypeFor[*aws01]()),
+				traceSkipIgnoredSourceField(reflect.TypeFor[tf01](), "Field3", reflect.TypeFor[*aws01]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandFieldReport(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1"`
+		Field2 types.String `tfsdk:"field2"`
+		Field3 types.String `tfsdk:"field3" autoflex:"-"`
+		Field4 types.String `tfsdk:"field4"`
+	}
+	type aws01 struct {
+		Field1 *string
+		Field4 bool
+		Field5 *string
+	}
+
+	testCases := autoFlexTestCases{
+		"report": {
+			Options: []AutoFlexOptionsFunc{WithFieldReport()},
+			Source: tf01{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+				Field3: types.StringValue("value3"),
+				Field4: types.StringValue("value4"),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				Field1: aws.String("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01]()),
+				infoConverting(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tf01](), "Field1", reflect.TypeFor[*aws01]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				debugNoCorrespondingField(reflect.TypeFor[tf01](), "Field2", reflect.TypeFor[*aws01]()),
+				traceSkipIgnoredSourceField(reflect.TypeFor[tf01](), "Field3", reflect.TypeFor[*aws01]()),
+				traceMatchedFields("Field4", reflect.TypeFor[tf01](), "Field4", reflect.TypeFor[*aws01]()),
+				infoConvertingWithPath("Field4", reflect.TypeFor[types.String](), "Field4", reflect.TypeFor[bool]()),
+				{
+					"@level":             "error",
+					"@module":            "provider.autoflex",
+					"@message":           "AutoFlex Expand; incompatible types",
+					"from":               map[string]any{},
+					"to":                 float64(reflect.Bool),
+					logAttrKeySourcePath: "Field4",
+					logAttrKeySourceType: fullTypeName(reflect.TypeFor[types.String]()),
+					logAttrKeyTargetPath: "Field4",
+					logAttrKeyTargetType: fullTypeName(reflect.TypeFor[bool]()),
+				},
+				infoFieldMappingReport(reflect.TypeFor[tf01](), reflect.TypeFor[*aws01](),
+					map[string]any{"source": "Field1", "target": "Field1", "status": "matched"},
+					map[string]any{"source": "Field2", "status": "unmatched"},
+					map[string]any{"source": "Field3", "status": "skipped", "reason": "ignored"},
+					map[string]any{"source": "Field4", "target": "Field4", "status": "incompatible"},
+					map[string]any{"target": "Field5", "status": "unmatched"},
+				),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -4868,6 +5015,219 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	unionMembers := WithUnionMembers(&awsUnionMemberStringValue{}, awsUnionMemberStructValue{})
+
+	testCases := autoFlexTestCases{
+		"single list Source and string member Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "StringValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", "awsUnionMemberStringValue", reflect.TypeFor[awsUnion]()),
+				infoConvertingWithPath("Field1[0].StringValue", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"single list Source and struct member Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStructValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", "awsUnionMemberStructValue", reflect.TypeFor[awsUnion]()),
+				infoConvertingWithPath("Field1[0].StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].StructValue[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].StructValue[0].Field1", reflect.TypeFor[types.String](), "Field1.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"object value Source and string member Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: tfObjectValue[tfUnion]{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &tfUnion{
+					StringValue: types.StringValue("value1"),
+					StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfObjectValue[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfObjectValue[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfObjectValue[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				traceMatchedUnionMember("Field1", "StringValue", reflect.TypeFor[fwtypes.ObjectValueOf[tfUnion]](), "Field1", "awsUnionMemberStringValue", reflect.TypeFor[awsUnion]()),
+				infoConvertingWithPath("Field1.StringValue", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"non-empty list Source and union slice Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberStructValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "StringValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1[0]", "awsUnionMemberStringValue", reflect.TypeFor[[]awsUnion]()),
+				infoConvertingWithPath("Field1[0].StringValue", reflect.TypeFor[types.String](), "Field1[0]", reflect.TypeFor[string]()),
+				traceMatchedUnionMember("Field1[1]", "StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1[1]", "awsUnionMemberStructValue", reflect.TypeFor[[]awsUnion]()),
+				infoConvertingWithPath("Field1[1].StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[1]", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[1].StructValue[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[1]", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[1].StructValue[0].Field1", reflect.TypeFor[types.String](), "Field1[1].Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"no members set": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+			},
+		},
+		"multiple members set": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "StringValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", "awsUnionMemberStringValue", reflect.TypeFor[awsUnion]()),
+				infoConvertingWithPath("Field1[0].StringValue", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+				errorExpandingMultipleUnionMembers("Field1[0]", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+			},
+		},
+		"member set with no corresponding union member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(&awsUnionMemberStringValue{})},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingNoUnionMember(reflect.TypeFor[tfUnion](), "StructValue", reflect.TypeFor[awsUnion]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				errorNoCorrespondingUnionMember("Field1[0]", "StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
@@ -190,10 +190,11 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -235,6 +236,7 @@ func (flattener autoFlattener) bool(ctx context.Context, vFrom reflect.Value, is
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -278,11 +280,13 @@ func (flattener autoFlattener) float64(ctx context.Context, vFrom reflect.Value,
 
 	case basetypes.Float32Typable:
 		// Only returns an error when the target type is Float32Typable to prevent breaking existing resources
+		reportIncompatibleTypes(ctx)
 		tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
 		diags.Append(DiagFlatteningIncompatibleTypes(sourceType, vTo.Type()))
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -361,6 +365,7 @@ func (flattener autoFlattener) float32(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -404,11 +409,13 @@ func (flattener autoFlattener) int64(ctx context.Context, vFrom reflect.Value, s
 
 	case basetypes.Int32Typable:
 		// Only returns an error when the target type is Int32Typeable to prevent breaking existing resources
+		reportIncompatibleTypes(ctx)
 		tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
 		diags.Append(DiagFlatteningIncompatibleTypes(sourceType, vTo.Type()))
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -479,6 +486,7 @@ func (flattener autoFlattener) int32(ctx context.Context, vFrom reflect.Value, i
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -521,6 +529,7 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -559,6 +568,7 @@ func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, is
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   vTo,
@@ -601,6 +611,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -609,7 +620,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,10 +664,11 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
 
 	return diags
@@ -686,6 +698,7 @@ func (flattener autoFlattener) struct_(ctx context.Context, sourcePath path.Path
 		return diags
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
 
 	return diags
@@ -837,6 +850,7 @@ func (flattener autoFlattener) slice(ctx context.Context, sourcePath path.Path,
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -1080,6 +1094,7 @@ func (flattener autoFlattener) map_(ctx context.Context, sourcePath path.Path, v
 		}
 	}
 
+	reportIncompatibleTypes(ctx)
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]interface{}{
 		"from": vFrom.Kind(),
 		"to":   tTo,
@@ -1215,7 +1230,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1236,6 +1251,22 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 	}
 
 	toFlattener, ok := to.(Flattener)
+	if !ok && flattener.Options.isUnionMember(vFrom.Elem().Type()) {
+		diags.Append(flattener.union(ctx, sourcePath, vFrom, targetPath, to)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Set the target structure as a mapped Object.
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
 	if !ok {
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
@@ -1245,6 +1276,7 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 		vTo.Set(reflect.ValueOf(val))
 
+		reportIncompatibleTypes(ctx)
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
 			"from": vFrom.Kind(),
 			"to":   tTo,
@@ -1277,6 +1309,44 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 	return diags
 }
 
+// union copies an AWS API union member value to the corresponding field of a compatible Plugin Framework nested object.
+// The nested object's other fields are set to null.
+func (flattener autoFlattener) union(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to)
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+	valTo = valTo.Elem()
+
+	tUnion, vMember := vFrom.Type(), vFrom.Elem()
+	memberName, _ := unionMemberName(tUnion, vMember.Type())
+
+	typeTo := valTo.Type()
+	for i := 0; i < typeTo.NumField(); i++ {
+		field := typeTo.Field(i)
+		if !field.IsExported() || !strings.EqualFold(field.Name, memberName) {
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: vMember.Type().Elem().Name(),
+			logAttrKeyTargetFieldname: field.Name,
+		})
+
+		diags.Append(flattener.convert(ctx, sourcePath, vMember.Elem().FieldByName(unionMemberValueFieldName), targetPath.AtName(field.Name), valTo.Field(i), fieldOpts{})...)
+		return diags
+	}
+
+	tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+		logAttrKeySourceFieldname: vMember.Type().Elem().Name(),
+	})
+
+	return diags
+}
+
 // sliceOfPrimtiveToList copies an AWS API slice of primitive (or pointer to primitive) value to a compatible Plugin Framework List value.
 func (flattener autoFlattener) sliceOfPrimtiveToList(ctx context.Context, vFrom reflect.Value, tTo basetypes.ListTypable, vTo reflect.Value, elementType attr.Type, attrValueFromReflectValue attrValueFromReflectValueFunc, fieldOpts fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1468,6 +1538,19 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 			return diags
 		}
 
+		// Map an AWS API union member to the corresponding populated field.
+		if vElem := vFrom.Index(i); vElem.Kind() == reflect.Interface && !vElem.IsNil() && flattener.Options.isUnionMember(vElem.Elem().Type()) {
+			if _, ok := target.(Flattener); !ok {
+				diags.Append(flattener.union(ctx, sourcePath, vElem, targetPath, target)...)
+				if diags.HasError() {
+					return diags
+				}
+
+				t.Index(i).Set(reflect.ValueOf(target))
+				continue
+			}
+		}
+
 		diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
 		if diags.HasError() {
 			return diags
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -4335,6 +4336,155 @@ func TestFlattenIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestFlattenFieldNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"tag name": {
+			Source: awsFieldNameStructTag{
+				Field1:    aws.String("value1"),
+				KMSKeyID:  aws.String("value2"),
+				KmsKeyArn: aws.String("value3"),
+			},
+			Target: &tfFieldNameStructTag{},
+			WantTarget: &tfFieldNameStructTag{
+				Field1:    types.StringValue("value1"),
+				KmsKeyArn: types.StringValue("value2"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsFieldNameStructTag](), reflect.TypeFor[*tfFieldNameStructTag]()),
+				infoConverting(reflect.TypeFor[awsFieldNameStructTag](), reflect.TypeFor[*tfFieldNameStructTag]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsFieldNameStructTag](), "Field1", reflect.TypeFor[*tfFieldNameStructTag]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				traceMatchedFields("KMSKeyID", reflect.TypeFor[awsFieldNameStructTag](), "KmsKeyArn", reflect.TypeFor[*tfFieldNameStructTag]()),
+				infoConvertingWithPath("KMSKeyID", reflect.TypeFor[*string](), "KmsKeyArn", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsFieldNameStructTag](), "KmsKeyArn", reflect.TypeFor[*tfFieldNameStructTag]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenErrorOnUnmatchedFields(t *testing.T) {
+	t.Parallel()
+
+	type aws01 struct {
+		Field1         *string
+		ResultMetadata middleware.Metadata
+	}
+	type aws02 struct {
+		Field1 *string
+		Field2 *string
+	}
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1"`
+	}
+
+	testCases := autoFlexTestCases{
+		"result metadata with option": {
+			Options: []AutoFlexOptionsFunc{WithErrorOnUnmatchedFields()},
+			Source: aws01{
+				Field1: aws.String("value1"),
+			},
+			Target: &tf01{},
+			WantTarget: &tf01{
+				Field1: types.StringValue("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[aws01](), reflect.TypeFor[*tf01]()),
+				infoConverting(reflect.TypeFor[aws01](), reflect.TypeFor[*tf01]()),
+				traceMatchedFields("Field1", reflect.TypeFor[aws01](), "Field1", reflect.TypeFor[*tf01]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[aws01](), "ResultMetadata", reflect.TypeFor[*tf01]()),
+			},
+		},
+		"unmatched field with option": {
+			Options: []AutoFlexOptionsFunc{WithErrorOnUnmatchedFields()},
+			Source: aws02{
+				Field1: aws.String("value1"),
+				Field2: aws.String("value2"),
+			},
+			Target: &tf01{},
+			expectedDiags: diag.Diagnostics{
+				diagConvertingUnmatchedField(reflect.TypeFor[aws02](), "Field2", reflect.TypeFor[tf01]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[aws02](), reflect.TypeFor[*tf01]()),
+				infoConverting(reflect.TypeFor[aws02](), reflect.TypeFor[*tf01]()),
+				traceMatchedFields("Field1", reflect.TypeFor[aws02](), "Field1", reflect.TypeFor[*tf01]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[aws02](), "Field2", reflect.TypeFor[*tf01]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenFieldReport(t *testing.T) {
+	t.Parallel()
+
+	type aws01 struct {
+		Field1 *string
+		Field2 *string
+		Field3 *string
+		Tags   map[string]string
+	}
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1"`
+		Field3 types.Bool   `tfsdk:"field3"`
+		Field4 types.String `tfsdk:"field4" autoflex:",noflatten"`
+		Field5 types.String `tfsdk:"field5"`
+	}
+
+	testCases := autoFlexTestCases{
+		"report": {
+			Options: []AutoFlexOptionsFunc{WithFieldReport()},
+			Source: aws01{
+				Field1: aws.String("value1"),
+				Field2: aws.String("value2"),
+				Field3: aws.String("value3"),
+			},
+			Target: &tf01{},
+			WantTarget: &tf01{
+				Field1: types.StringValue("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[aws01](), reflect.TypeFor[*tf01]()),
+				infoConverting(reflect.TypeFor[aws01](), reflect.TypeFor[*tf01]()),
+				traceMatchedFields("Field1", reflect.TypeFor[aws01](), "Field1", reflect.TypeFor[*tf01]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[aws01](), "Field2", reflect.TypeFor[*tf01]()),
+				traceMatchedFields("Field3", reflect.TypeFor[aws01](), "Field3", reflect.TypeFor[*tf01]()),
+				infoConvertingWithPath("Field3", reflect.TypeFor[*string](), "Field3", reflect.TypeFor[types.Bool]()),
+				{
+					"@level":             "error",
+					"@module":            "provider.autoflex",
+					"@message":           "AutoFlex Flatten; incompatible types",
+					"from":               float64(reflect.String),
+					"to":                 map[string]any{},
+					logAttrKeySourcePath: "Field3",
+					logAttrKeySourceType: fullTypeName(reflect.TypeFor[*string]()),
+					logAttrKeyTargetPath: "Field3",
+					logAttrKeyTargetType: fullTypeName(reflect.TypeFor[types.Bool]()),
+				},
+				traceSkipIgnoredSourceField(reflect.TypeFor[aws01](), "Tags", reflect.TypeFor[*tf01]()),
+				infoFieldMappingReport(reflect.TypeFor[aws01](), reflect.TypeFor[*tf01](),
+					map[string]any{"source": "Field1", "target": "Field1", "status": "matched"},
+					map[string]any{"source": "Field2", "status": "unmatched"},
+					map[string]any{"source": "Field3", "target": "Field3", "status": "incompatible"},
+					map[string]any{"source": "Tags", "status": "skipped", "reason": "ignored"},
+					map[string]any{"target": "Field4", "status": "skipped", "reason": "noflatten"},
+					map[string]any{"target": "Field5", "status": "unmatched"},
+				),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenInterfaceToStringTypable(t *testing.T) {
 	t.Parallel()
 
@@ -4802,6 +4952,160 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	unionMembers := WithUnionMembers(&awsUnionMemberStringValue{}, awsUnionMemberStructValue{})
+
+	testCases := autoFlexTestCases{
+		"nil union Source and list Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"string member Source and single list Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberStringValue", reflect.TypeFor[awsUnion](), "Field1", "StringValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field1.StringValue", reflect.TypeFor[types.String]()),
+			},
+		},
+		"struct member Source and single list Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStructValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberStructValue", reflect.TypeFor[awsUnion](), "Field1", "StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.StructValue", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Field1", reflect.TypeFor[string](), "Field1.StructValue.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"string member Source and object value Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &tfObjectValue[tfUnion]{},
+			WantTarget: &tfObjectValue[tfUnion]{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &tfUnion{
+					StringValue: types.StringValue("value1"),
+					StructValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfObjectValue[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfObjectValue[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfObjectValue[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberStringValue", reflect.TypeFor[awsUnion](), "Field1", "StringValue", reflect.TypeFor[fwtypes.ObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field1.StringValue", reflect.TypeFor[types.String]()),
+			},
+		},
+		"union slice Source and non-empty list Target": {
+			Options: []AutoFlexOptionsFunc{unionMembers},
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberStructValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceMatchedUnionMember("Field1[0]", "awsUnionMemberStringValue", reflect.TypeFor[[]awsUnion](), "Field1[0]", "StringValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1[0]", reflect.TypeFor[string](), "Field1[0].StringValue", reflect.TypeFor[types.String]()),
+				traceMatchedUnionMember("Field1[1]", "awsUnionMemberStructValue", reflect.TypeFor[[]awsUnion](), "Field1[1]", "StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoConvertingWithPath("Field1[1]", reflect.TypeFor[awsSingleStringValue](), "Field1[1].StructValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1]", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].StructValue", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Field1", reflect.TypeFor[string](), "Field1[1].StructValue.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/aws/smithy-go/middleware"
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -21,8 +22,12 @@ type fieldNamePrefixCtxKey string
 const (
 	fieldNamePrefixRecurse fieldNamePrefixCtxKey = "FIELD_NAME_PREFIX_RECURSE"
 	fieldNameSuffixRecurse fieldNamePrefixCtxKey = "FIELD_NAME_SUFFIX_RECURSE"
+	fieldReportEntryCtxKey fieldNamePrefixCtxKey = "FIELD_REPORT_ENTRY"
 
 	mapBlockKeyFieldName = "MapBlockKey"
+
+	// unionMemberValueFieldName is the name of the field holding an AWS API union member's value.
+	unionMemberValueFieldName = "Value"
 )
 
 // Expand  = TF -->  AWS
@@ -102,6 +107,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		reportIncompatibleTypes(ctx)
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -120,6 +126,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 	typeTo := valTo.Type()
 
 	opts := flexer.getOptions()
+	report := newFieldReport(opts)
 	for i := 0; i < typeFrom.NumField(); i++ {
 		fromField := typeFrom.Field(i)
 		if fromField.PkgPath != "" {
@@ -131,6 +138,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			report.add(fieldName, "", fieldStatusSkipped, fieldSkipReasonIgnored)
 			continue
 		}
 		// TODO: this only applies when Expanding
@@ -138,21 +146,27 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			report.add(fieldName, "", fieldStatusSkipped, fieldSkipReasonIgnored)
 			continue
 		}
 		if fieldName == mapBlockKeyFieldName {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
 				logAttrKeySourceFieldname: mapBlockKeyFieldName,
 			})
+			report.add(fieldName, "", fieldStatusSkipped, fieldSkipReasonMapBlockKey)
 			continue
 		}
 
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		toField, ok := findField(ctx, fromField, typeFrom, typeTo, flexer)
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			report.add(fieldName, "", fieldStatusUnmatched, "")
+			if opts.errorOnUnmatchedFields && fromField.Type != reflect.TypeFor[middleware.Metadata]() {
+				diags.Append(diagConvertingUnmatchedField(typeFrom, fieldName, typeTo))
+			}
 			continue
 		}
 		toFieldName := toField.Name
@@ -164,6 +178,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			report.add(fieldName, toFieldName, fieldStatusSkipped, fieldSkipReasonIgnored)
 			continue
 		}
 		if toOpts.NoFlatten() {
@@ -171,6 +186,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			report.add(fieldName, toFieldName, fieldStatusSkipped, fieldSkipReasonNoFlatten)
 			continue
 		}
 		if !toFieldVal.CanSet() {
@@ -179,6 +195,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			report.add(fieldName, toFieldName, fieldStatusSkipped, fieldSkipReasonCannotBeSet)
 			continue
 		}
 
@@ -192,15 +209,54 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			omitempty: toOpts.OmitEmpty(),
 		}
 
-		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
-		if diags.HasError() {
+		ctx := contextWithFieldReportEntry(ctx, report.add(fieldName, toFieldName, fieldStatusMatched, ""))
+		d := flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)
+		diags.Append(d...)
+		if d.HasError() {
 			break
 		}
 	}
 
+	report.log(ctx, typeTo, opts)
+
 	return diags
 }
 
+// findField returns the target struct field corresponding to source struct field fromField.
+// A field name set in the `autoflex` tag of either field takes precedence over matching by name,
+// and a target field with a name set in its tag is only matched by that name.
+func findField(ctx context.Context, fromField reflect.StructField, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
+	if name, ok := fieldNameOverride(fromField); ok {
+		return typeTo.FieldByName(name)
+	}
+
+	for i := 0; i < typeTo.NumField(); i++ {
+		field := typeTo.Field(i)
+		if name, ok := fieldNameOverride(field); ok && field.IsExported() && name == fromField.Name {
+			return field, true
+		}
+	}
+
+	toField, ok := findFieldFuzzy(ctx, fromField.Name, typeFrom, typeTo, flexer)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+	if _, ok := fieldNameOverride(toField); ok {
+		return reflect.StructField{}, false
+	}
+
+	return toField, true
+}
+
+// fieldNameOverride returns the name of the corresponding field set in a struct field's `autoflex` tag, e.g. `autoflex:"KMSKeyID"`.
+func fieldNameOverride(field reflect.StructField) (string, bool) {
+	name, _ := autoflexTags(field)
+	if name == "" || name == "-" {
+		return "", false
+	}
+	return name, true
+}
+
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
 	// first precedence is exact match (case sensitive)
 	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
@@ -280,6 +336,29 @@ func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	return ok
 }
 
+// unionMemberName returns the member name of union member type tMember, e.g. ApiKeyCredential
+// for union Credential and member type *CredentialMemberApiKeyCredential.
+func unionMemberName(tUnion, tMember reflect.Type) (string, bool) {
+	if tMember.Kind() == reflect.Pointer {
+		tMember = tMember.Elem()
+	}
+	if _, ok := tMember.FieldByName(unionMemberValueFieldName); !ok {
+		return "", false
+	}
+	return strings.CutPrefix(tMember.Name(), tUnion.Name()+"Member")
+}
+
+// isUnionMemberSet returns true if a union member's nested object field is populated.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+	if v, ok := v.(valueWithElementsAs); ok {
+		return len(v.Elements()) > 0
+	}
+	return true
+}
+
 func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
@@ -307,6 +386,16 @@ func diagConvertingTargetIsNil(targetType reflect.Type) diag.ErrorDiagnostic {
 	)
 }
 
+func diagConvertingUnmatchedField(sourceType reflect.Type, fieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unmatched Field",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q of source type %q has no corresponding field in target type %q", fieldName, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
 func diagConvertingTargetIsNotPointer(targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
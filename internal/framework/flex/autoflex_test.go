@@ -37,6 +37,17 @@ type tfSingleStringFieldLegacy struct {
 	Field1 types.String `tfsdk:"field1" autoflex:",legacy"`
 }
 
+type tfFieldNameStructTag struct {
+	Field1    types.String `tfsdk:"field1"`
+	KmsKeyArn types.String `tfsdk:"kms_key_arn" autoflex:"KMSKeyID"`
+}
+
+type awsFieldNameStructTag struct {
+	Field1    *string
+	KMSKeyID  *string
+	KmsKeyArn *string
+}
+
 type tfSingleFloat64Field struct {
 	Field1 types.Float64 `tfsdk:"field1"`
 }
@@ -558,6 +569,39 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfUnion struct {
+	StringValue types.String                                         `tfsdk:"string_value"`
+	StructValue fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"struct_value"`
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberStringValue struct {
+	Value string
+}
+
+var _ awsUnion = &awsUnionMemberStringValue{}
+
+func (t *awsUnionMemberStringValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberStructValue struct {
+	Value awsSingleStringValue
+}
+
+var _ awsUnion = &awsUnionMemberStructValue{}
+
+func (t *awsUnionMemberStructValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
@@ -24,7 +24,8 @@ const (
 	logAttrKeyTargetFieldname = "autoflex.target.fieldname"
 	logAttrKeyTargetPath      = "autoflex.target.path"
 
-	logAttrKeyError = "error"
+	logAttrKeyError  = "error"
+	logAttrKeyFields = "autoflex.fields"
 )
 
 const (
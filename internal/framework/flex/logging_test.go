@@ -256,6 +256,45 @@ func traceMatchedFieldsWithPath(sourcePath, sourceFieldName string, sourceType r
 	}
 }
 
+func traceMatchedUnionMember(sourcePath, sourceFieldName string, sourceType reflect.Type, targetPath, targetFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
+func errorExpandingMultipleUnionMembers(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Error.String(),
+		"@module":            logModule,
+		"@message":           "Multiple union members set",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
+func errorNoCorrespondingUnionMember(sourcePath, sourceFieldName string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Error.String(),
+		"@module":                 logModule,
+		"@message":                "No corresponding union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+	}
+}
+
 func debugNoCorrespondingField(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":                  hclog.Debug.String(),
@@ -638,6 +677,24 @@ func debugUsingLegacyFlattener(sourcePath string, sourceType reflect.Type, targe
 	}
 }
 
+func infoFieldMappingReport(sourceType reflect.Type, targetType reflect.Type, fields ...map[string]any) map[string]any {
+	return logInfo("Field mapping report", map[string]any{
+		logAttrKeySourcePath: "",
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: "",
+		logAttrKeyTargetType: fullTypeName(targetType),
+		logAttrKeyFields:     reportFields(fields),
+	})
+}
+
+func reportFields(fields []map[string]any) []any {
+	result := make([]any, len(fields))
+	for i, field := range fields {
+		result[i] = field
+	}
+	return result
+}
+
 func infoLogLine(message string, sourceType, targetType reflect.Type) map[string]any {
 	return logInfo(message, map[string]any{
 		logAttrKeySourceType: fullTypeName(sourceType),
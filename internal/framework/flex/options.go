@@ -3,6 +3,11 @@
 
 package flex
 
+import (
+	"reflect"
+	"strings"
+)
+
 var (
 	DefaultIgnoredFieldNames = []string{
 		"Tags", // Resource tags are handled separately.
@@ -25,6 +30,18 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// errorOnUnmatchedFields specifies whether a source field with no
+	// corresponding target field is an error
+	errorOnUnmatchedFields bool
+
+	// fieldReport specifies whether expanders and flatteners log a report
+	// of how each struct field was mapped
+	fieldReport bool
+
+	// unionMemberTypes stores the pointer types of AWS API union members
+	// which expanders and flatteners map to and from nested objects
+	unionMemberTypes []reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -75,6 +92,48 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithErrorOnUnmatchedFields causes expanders and flatteners to return an error
+// for each source struct field that has no corresponding target field.
+//
+// Fields which are ignored, either by option or with the `autoflex:"-"` tag,
+// and AWS API output ResultMetadata fields are not considered unmatched.
+func WithErrorOnUnmatchedFields() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.errorOnUnmatchedFields = true
+	}
+}
+
+// WithFieldReport causes expanders and flatteners to log, at INFO level, a report
+// for each struct converted listing every source and target field and whether it
+// was matched, skipped, unmatched or had incompatible types.
+//
+// Set TF_LOG_AWS_AUTOFLEX=INFO to view the report.
+func WithFieldReport() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.fieldReport = true
+	}
+}
+
+// WithUnionMembers registers the member types of one or more AWS API unions,
+// e.g. &awstypes.CredentialMemberApiKeyCredential{}
+//
+// Use this option to expand a nested object with one populated field per union
+// member to the union's interface type, and to flatten the union back.
+// A field maps to the member whose type name is the union's name, "Member" and the
+// field name, e.g. field ApiKeyCredential maps to CredentialMemberApiKeyCredential
+// for union Credential.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		for _, member := range members {
+			t := reflect.TypeOf(member)
+			if t.Kind() != reflect.Pointer {
+				t = reflect.PointerTo(t)
+			}
+			o.unionMemberTypes = append(o.unionMemberTypes, t)
+		}
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	for _, name := range o.ignoredFieldNames {
@@ -84,3 +143,39 @@ func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	}
 	return false
 }
+
+// isUnion returns true if any registered union member implements the interface type t
+func (o *AutoFlexOptions) isUnion(t reflect.Type) bool {
+	if t.Kind() != reflect.Interface {
+		return false
+	}
+	for _, member := range o.unionMemberTypes {
+		if member.Implements(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnionMember returns true if t is a registered union member type
+func (o *AutoFlexOptions) isUnionMember(t reflect.Type) bool {
+	for _, member := range o.unionMemberTypes {
+		if t == member {
+			return true
+		}
+	}
+	return false
+}
+
+// unionMemberType returns the registered member type of union t whose member name matches s
+func (o *AutoFlexOptions) unionMemberType(t reflect.Type, s string) (reflect.Type, bool) {
+	for _, member := range o.unionMemberTypes {
+		if !member.Implements(t) {
+			continue
+		}
+		if name, ok := unionMemberName(t, member); ok && strings.EqualFold(name, s) {
+			return member, true
+		}
+	}
+	return nil, false
+}
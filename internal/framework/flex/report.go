@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// fieldStatus is the outcome of mapping a struct field during conversion.
+type fieldStatus string
+
+const (
+	fieldStatusIncompatible fieldStatus = "incompatible"
+	fieldStatusMatched      fieldStatus = "matched"
+	fieldStatusSkipped      fieldStatus = "skipped"
+	fieldStatusUnmatched    fieldStatus = "unmatched"
+)
+
+// Reasons for skipping a field.
+const (
+	fieldSkipReasonCannotBeSet = "cannot be set"
+	fieldSkipReasonIgnored     = "ignored"
+	fieldSkipReasonMapBlockKey = "map block key"
+	fieldSkipReasonNoFlatten   = "noflatten"
+)
+
+type fieldReportEntry struct {
+	source string
+	target string
+	status fieldStatus
+	reason string
+}
+
+func (e *fieldReportEntry) attrs() map[string]string {
+	attrs := map[string]string{
+		"status": string(e.status),
+	}
+	if e.source != "" {
+		attrs["source"] = e.source
+	}
+	if e.target != "" {
+		attrs["target"] = e.target
+	}
+	if e.reason != "" {
+		attrs["reason"] = e.reason
+	}
+	return attrs
+}
+
+// fieldReport records how each field of a source struct was mapped to a target struct.
+// A nil *fieldReport records nothing.
+type fieldReport struct {
+	entries []*fieldReportEntry
+}
+
+func newFieldReport(opts AutoFlexOptions) *fieldReport {
+	if !opts.fieldReport {
+		return nil
+	}
+	return &fieldReport{}
+}
+
+func (r *fieldReport) add(source, target string, status fieldStatus, reason string) *fieldReportEntry {
+	if r == nil {
+		return nil
+	}
+	entry := &fieldReportEntry{
+		source: source,
+		target: target,
+		status: status,
+		reason: reason,
+	}
+	r.entries = append(r.entries, entry)
+	return entry
+}
+
+// log emits the report, followed by any exported fields of typeTo that no source field was mapped to.
+func (r *fieldReport) log(ctx context.Context, typeTo reflect.Type, opts AutoFlexOptions) {
+	if r == nil {
+		return
+	}
+
+	fields := make([]map[string]string, 0, len(r.entries))
+	targets := make(map[string]bool)
+	for _, entry := range r.entries {
+		fields = append(fields, entry.attrs())
+		if entry.target != "" {
+			targets[entry.target] = true
+		}
+	}
+
+	for i := 0; i < typeTo.NumField(); i++ {
+		field := typeTo.Field(i)
+		if !field.IsExported() || targets[field.Name] {
+			continue
+		}
+		entry := fieldReportEntry{target: field.Name, status: fieldStatusUnmatched}
+		if name, tagOpts := autoflexTags(field); name == "-" || opts.isIgnoredField(field.Name) {
+			entry.status, entry.reason = fieldStatusSkipped, fieldSkipReasonIgnored
+		} else if tagOpts.NoFlatten() {
+			entry.status, entry.reason = fieldStatusSkipped, fieldSkipReasonNoFlatten
+		}
+		fields = append(fields, entry.attrs())
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Field mapping report", map[string]any{
+		logAttrKeyFields: fields,
+	})
+}
+
+// contextWithFieldReportEntry returns a context that records type incompatibilities in entry.
+func contextWithFieldReportEntry(ctx context.Context, entry *fieldReportEntry) context.Context {
+	if entry == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, fieldReportEntryCtxKey, entry)
+}
+
+// reportIncompatibleTypes marks the field being converted, if any, as having incompatible types.
+func reportIncompatibleTypes(ctx context.Context) {
+	if entry, ok := ctx.Value(fieldReportEntryCtxKey).(*fieldReportEntry); ok {
+		entry.status = fieldStatusIncompatible
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is embedded in list resources for Terraform Plugin SDK resources.
+// List results are built from a *schema.ResourceData for the SDK resource, populated by the list resource.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resource *schema.Resource
+}
+
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetResource sets the registered SDK resource whose remote objects are listed.
+func (l *ListResourceWithSDKv2Resource) SetResource(r *schema.Resource) {
+	l.resource = r
+}
+
+func (l *ListResourceWithSDKv2Resource) ListResourceConfigSchema(context.Context, list.ListResourceSchemaRequest, *list.ListResourceSchemaResponse) {
+	// No list arguments.
+}
+
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = l.resource.ProtoIdentitySchema(ctx)()
+}
+
+// ResourceData returns an empty *schema.ResourceData for the SDK resource.
+// The list resource sets the remote object's ID and identity attributes before calling SetResult.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resource.Data(&terraform.InstanceState{})
+}
+
+// SetResult sets the list result's identity from d.
+// If includeResource is set, the SDK resource's Read handler is called and the list result's resource is set from the refreshed state.
+// On error the list result contains only diagnostics.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, includeResource bool, d *schema.ResourceData, result *list.ListResult) {
+	// Remote objects are listed in the effective Region.
+	if _, ok := l.resource.SchemaMap()[names.AttrRegion]; ok && d.Get(names.AttrRegion).(string) == "" {
+		if err := d.Set(names.AttrRegion, l.Meta().Region(ctx)); err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("setting %s", names.AttrRegion), err.Error())
+			*result = list.ListResult{Diagnostics: result.Diagnostics}
+			return
+		}
+	}
+
+	if includeResource {
+		if err := l.setResource(ctx, d, result); err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("reading %s", d.Id()), err.Error())
+			*result = list.ListResult{Diagnostics: result.Diagnostics}
+			return
+		}
+	}
+
+	for name := range l.resource.Identity.SchemaMap() {
+		var v string
+		if name == names.AttrID {
+			v = d.Id()
+		} else {
+			v = d.Get(name).(string)
+		}
+
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), v)...)
+	}
+
+	if result.Diagnostics.HasError() {
+		*result = list.ListResult{Diagnostics: result.Diagnostics}
+	}
+}
+
+// setResource refreshes d and sets the list result's resource from the refreshed state.
+// The refreshed attribute values are also set in d, so that identity attributes reflect the remote object.
+func (l *ListResourceWithSDKv2Resource) setResource(ctx context.Context, d *schema.ResourceData, result *list.ListResult) error {
+	state, diags := l.resource.RefreshWithoutUpgrade(ctx, d.State(), l.Meta())
+
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		return err
+	}
+
+	if state == nil {
+		return fmt.Errorf("%s not found", d.Id())
+	}
+
+	ty := l.resource.CoreConfigSchema().ImpliedType()
+	v, err := schema.StateValueFromInstanceState(state, ty)
+
+	if err != nil {
+		return err
+	}
+
+	b, err := msgpack.Marshal(v, ty)
+
+	if err != nil {
+		return err
+	}
+
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: b}).Unmarshal(result.Resource.Schema.Type().TerraformType(ctx))
+
+	if err != nil {
+		return err
+	}
+
+	result.Resource.Raw = raw
+
+	for name := range l.resource.Identity.SchemaMap() {
+		if name == names.AttrID {
+			continue
+		}
+
+		if err := d.Set(name, state.Attributes[name]); err != nil {
+			return fmt.Errorf("setting %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestListResourceWithSDKv2ResourceSetResult(t *testing.T) {
+	t.Parallel()
+
+	newResource := func(readErr error) *schema.Resource {
+		return &schema.Resource{
+			ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				if readErr != nil {
+					return diag.FromErr(readErr)
+				}
+
+				d.Set(names.AttrDescription, "read")
+
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				names.AttrDescription: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+			Identity: &schema.ResourceIdentity{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						names.AttrName: {
+							Type:              schema.TypeString,
+							RequiredForImport: true,
+						},
+					}
+				},
+			},
+		}
+	}
+	request := list.ListRequest{
+		ResourceSchema: resourceschema.Schema{
+			Attributes: map[string]resourceschema.Attribute{
+				names.AttrDescription: resourceschema.StringAttribute{
+					Computed: true,
+				},
+				names.AttrID: resourceschema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrName: resourceschema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				names.AttrName: identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		includeResource bool
+		readErr         error
+		wantDescription string
+		wantErr         bool
+	}{
+		"identity only": {},
+		"include resource": {
+			includeResource: true,
+			wantDescription: "read",
+		},
+		"read error": {
+			includeResource: true,
+			readErr:         errors.New("test"),
+			wantErr:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var l ListResourceWithSDKv2Resource
+			l.meta = &conns.AWSClient{}
+			l.SetResource(newResource(testCase.readErr))
+
+			d := l.ResourceData()
+			d.SetId("test")
+			d.Set(names.AttrName, "test")
+
+			result := request.NewListResult(ctx)
+			l.SetResult(ctx, testCase.includeResource, d, &result)
+
+			if got, want := result.Diagnostics.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, result.Diagnostics)
+			}
+			if testCase.wantErr {
+				if result.Identity != nil || result.Resource != nil {
+					t.Errorf("result with errors has identity or resource")
+				}
+				return
+			}
+
+			var identityName types.String
+			result.Diagnostics.Append(result.Identity.GetAttribute(ctx, path.Root(names.AttrName), &identityName)...)
+			if result.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", result.Diagnostics)
+			}
+			if got, want := identityName.ValueString(), "test"; got != want {
+				t.Errorf("identity %s = %q, want %q", names.AttrName, got, want)
+			}
+
+			if got, want := !result.Resource.Raw.IsNull(), testCase.includeResource; got != want {
+				t.Fatalf("resource set = %t, want %t", got, want)
+			}
+			if !testCase.includeResource {
+				return
+			}
+
+			var description types.String
+			result.Diagnostics.Append(result.Resource.GetAttribute(ctx, path.Root(names.AttrDescription), &description)...)
+			if result.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", result.Diagnostics)
+			}
+			if got, want := description.ValueString(), testCase.wantDescription; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrDescription, got, want)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -49,6 +51,12 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
+
+			r.checkTagPolicy(ctx, defaultTagsConfig.MergeTags(resourceTags), &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
@@ -60,6 +68,23 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	}
 }
 
+// checkTagPolicy adds a diagnostic for each violation of the provider's tag policy by the specified tags.
+func (r *ResourceWithConfigure) checkTagPolicy(ctx context.Context, tags tftags.KeyValueTags, diags *diag.Diagnostics) {
+	var typeName string
+	if inContext, ok := conns.FromContext(ctx); ok {
+		typeName = inContext.TypeName
+	}
+
+	for _, v := range r.Meta().TagPolicyConfig(ctx).Evaluate(tags) {
+		summary, detail := "Tag Policy Violation", fmt.Sprintf("%s: %s", typeName, v)
+		if v.IsError() {
+			diags.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		} else {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+		}
+	}
+}
+
 type mapValueElementsable interface {
 	Elements() map[string]attr.Value
 }
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceWithConfigureSetTagsAll_tagPolicy(t *testing.T) {
+	t.Parallel()
+
+	meta := &conns.AWSClient{}
+	conns.SetTagPolicyConfig(meta, &tftags.PolicyConfig{
+		Rules: []tftags.PolicyRule{
+			{
+				Key: "owner",
+			},
+			{
+				AllowedValues: []string{"dev", "prod"},
+				Key:           "environment",
+				Severity:      tftags.PolicySeverityWarning,
+			},
+		},
+	})
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: tftags.MapType.ElemType,
+				Optional:    true,
+			},
+			names.AttrTagsAll: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: tftags.MapType.ElemType,
+				Computed:    true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		tags map[string]string
+		want diag.Diagnostics
+	}{
+		{
+			name: "compliant",
+			tags: map[string]string{
+				"environment": "prod",
+				"owner":       "finops",
+			},
+		},
+		{
+			name: "warning",
+			tags: map[string]string{
+				"environment": "test",
+				"owner":       "finops",
+			},
+			want: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root(names.AttrTags), "Tag Policy Violation", `: tag "environment" has value "test", expected one of [dev, prod]`),
+			},
+		},
+		{
+			name: "error and warning",
+			tags: map[string]string{
+				"environment": "test",
+			},
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root(names.AttrTags), "Tag Policy Violation", `: tag "owner" is required`),
+				diag.NewAttributeWarningDiagnostic(path.Root(names.AttrTags), "Tag Policy Violation", `: tag "environment" has value "test", expected one of [dev, prod]`),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			tags := make(map[string]tftypes.Value)
+			for k, v := range testCase.tags {
+				tags[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			plan := tfsdk.Plan{
+				Schema: s,
+				Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+					names.AttrTags:    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tags),
+					names.AttrTagsAll: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				}),
+			}
+
+			var r ResourceWithConfigure
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+
+			request := resource.ModifyPlanRequest{Plan: plan}
+			response := resource.ModifyPlanResponse{Plan: plan}
+			r.SetTagsAll(ctx, request, &response)
+
+			if got, want := response.Diagnostics, testCase.want; !got.Equal(want) {
+				t.Errorf("diagnostics = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionValidator validates that a string Attribute's value is a valid schedule expression.
+type scheduleExpressionValidator struct {
+	options []schedule.Option
+}
+
+func (validator scheduleExpressionValidator) Description(_ context.Context) string {
+	return "value must be a valid schedule expression"
+}
+
+func (validator scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator scheduleExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := schedule.Parse(request.ConfigValue.ValueString(), validator.options...); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s (%s)", validator.Description(ctx), err),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// ScheduleExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid at(...), cron(...) or rate(...) schedule expression,
+//     restricted by any options, e.g. schedule.WithTypes(schedule.TypeCron).
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ScheduleExpression(options ...schedule.Option) validator.String {
+	return scheduleExpressionValidator{
+		options: options,
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrAllocateFunction{}
+
+func NewCIDRAllocateFunction() function.Function {
+	return &cidrAllocateFunction{}
+}
+
+type cidrAllocateFunction struct{}
+
+func (f cidrAllocateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_allocate"
+}
+
+func (f cidrAllocateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_allocate Function",
+		MarkdownDescription: "Allocates non-overlapping CIDR blocks with the requested prefix lengths from a parent CIDR block. " +
+			"Blocks are packed largest first so that no address space is wasted on alignment, and are returned in the order requested.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "Parent IPv4 or IPv6 CIDR block",
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Prefix lengths of the CIDR blocks to allocate",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrAllocateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := allocateCIDRBlocks(cidrBlock, prefixLengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// allocateCIDRBlocks packs CIDR blocks with the specified prefix lengths into the parent CIDR block.
+// The allocated CIDR blocks are returned in the order of the requested prefix lengths.
+func allocateCIDRBlocks(cidrBlock string, prefixLengths []int64) ([]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	parent, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	addrBits := parent.Addr().BitLen()
+	for _, v := range prefixLengths {
+		if v < int64(parent.Bits()) || v > int64(addrBits) {
+			return nil, fmt.Errorf("prefix length %d is not between %d and %d", v, parent.Bits(), addrBits)
+		}
+	}
+
+	// Allocating the largest blocks first keeps every subsequent block naturally aligned.
+	order := make([]int, len(prefixLengths))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(prefixLengths[a], prefixLengths[b])
+	})
+
+	start := new(big.Int).SetBytes(parent.Addr().AsSlice())
+	end := new(big.Int).Add(start, blockSize(addrBits, parent.Bits()))
+	next := new(big.Int).Set(start)
+	result := make([]string, len(prefixLengths))
+
+	for _, i := range order {
+		bits := int(prefixLengths[i])
+		size := blockSize(addrBits, bits)
+
+		if new(big.Int).Add(next, size).Cmp(end) > 0 {
+			return nil, fmt.Errorf("insufficient address space in %s to allocate /%d", cidrBlock, bits)
+		}
+
+		b := make([]byte, addrBits/8)
+		next.FillBytes(b)
+		addr, _ := netip.AddrFromSlice(b)
+		result[i] = netip.PrefixFrom(addr, bits).String()
+
+		next.Add(next, size)
+	}
+
+	return result, nil
+}
+
+// blockSize returns the number of addresses in a CIDR block with the specified prefix length.
+func blockSize(addrBits, prefixLength int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(addrBits-prefixLength))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRAllocateFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRAllocateFunctionConfig("10.0.0.0/16", "24, 20, 24, 18"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.80.0/24,10.0.64.0/20,10.0.81.0/24,10.0.0.0/18"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRAllocateFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRAllocateFunctionConfig("2001:db8::/56", "64, 60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2001:db8:0:10::/64,2001:db8::/60"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRAllocateFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRAllocateFunctionConfig("10.0.0.0/24", "25, 25, 26"),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestCIDRAllocateFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRAllocateFunctionConfig("10.0.0.0/24", "16"),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*16`),
+			},
+		},
+	})
+}
+
+func testCIDRAllocateFunctionConfig(cidrBlock, prefixLengths string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_allocate(%[1]q, [%[2]s]))
+}
+`, cidrBlock, prefixLengths)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// privateAddressPrefixes are the address ranges reserved for private networks.
+var privateAddressPrefixes = []netip.Prefix{
+	// RFC 1918.
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	// RFC 4193 Unique Local Addresses.
+	netip.MustParsePrefix("fc00::/7"),
+	// RFC 3879 (deprecated) site-local addresses.
+	netip.MustParsePrefix("fec0::/10"),
+}
+
+var _ function.Function = cidrIsRFC1918Function{}
+
+func NewCIDRIsRFC1918Function() function.Function {
+	return &cidrIsRFC1918Function{}
+}
+
+type cidrIsRFC1918Function struct{}
+
+func (f cidrIsRFC1918Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_is_rfc1918"
+}
+
+func (f cidrIsRFC1918Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_is_rfc1918 Function",
+		MarkdownDescription: "Returns whether a CIDR block lies entirely within an address range reserved for private networks: " +
+			"the RFC 1918 IPv4 ranges, or the IPv6 unique local (fc00::/7) and site-local (fec0::/10) ranges.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrIsRFC1918Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := isPrivateCIDRBlock(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// isPrivateCIDRBlock returns whether the specified CIDR block lies entirely within a private address range.
+func isPrivateCIDRBlock(s string) (bool, error) {
+	if err := itypes.ValidateCIDRBlock(s); err != nil {
+		return false, err
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range privateAddressPrefixes {
+		if v.Bits() <= prefix.Bits() && v.Contains(prefix.Addr()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
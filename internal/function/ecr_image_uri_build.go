@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ecrImageURIBuildFunction{}
+
+func NewECRImageURIBuildFunction() function.Function {
+	return &ecrImageURIBuildFunction{}
+}
+
+type ecrImageURIBuildFunction struct{}
+
+func (f ecrImageURIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_build"
+}
+
+func (f ecrImageURIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_image_uri_build Function",
+		MarkdownDescription: "Builds an Amazon ECR container image URI from its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "account_id",
+				MarkdownDescription: "AWS account identifier of the registry",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code of the registry",
+			},
+			function.StringParameter{
+				Name:                "repository",
+				MarkdownDescription: "Repository name",
+			},
+			function.StringParameter{
+				Name:                "tag",
+				MarkdownDescription: "Image tag. May be null or empty",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "digest",
+				MarkdownDescription: "Image digest, e.g. sha256:... May be null or empty",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ecrImageURIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var accountID, region, repository string
+	var tag, digest types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &accountID, &region, &repository, &tag, &digest))
+	if resp.Error != nil {
+		return
+	}
+
+	result := ecrImageURI{
+		accountID:  accountID,
+		digest:     digest.ValueString(),
+		region:     region,
+		repository: repository,
+		tag:        tag.ValueString(),
+	}.String()
+
+	// Ensure that the result can be parsed.
+	if _, err := parseECRImageURI(result); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
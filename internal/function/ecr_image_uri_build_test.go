@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageURIBuildFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::ecr_image_uri_build("444455556666", "us-west-2", "team/app", "v1.2", null)
+}
+
+output "china" {
+  value = provider::aws::ecr_image_uri_build("444455556666", "cn-north-1", "app", null, "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "444455556666.dkr.ecr.us-west-2.amazonaws.com/team/app:v1.2"),
+					resource.TestCheckOutput("china", "444455556666.dkr.ecr.cn-north-1.amazonaws.com.cn/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIBuildFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::ecr_image_uri_build("4444", "us-west-2", "app", null, null)
+}
+`,
+				ExpectError: regexache.MustCompile("invalid Amazon ECR image URI"),
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ecrImageURIRegexp matches ACCOUNT.dkr.ecr.REGION.DNS_SUFFIX/REPOSITORY[:TAG][@DIGEST].
+var ecrImageURIRegexp = regexache.MustCompile(`^(\d{12})\.dkr\.ecr\.([a-z0-9-]+)\.([a-z0-9.-]+)/([a-z0-9]+(?:[._-][a-z0-9]+)*(?:/[a-z0-9]+(?:[._-][a-z0-9]+)*)*)(?::([0-9A-Za-z_][0-9A-Za-z_.-]{0,127}))?(?:@(sha256:[0-9a-f]{64}))?$`)
+
+var ecrImageURIParseResultAttrTypes = map[string]attr.Type{
+	"account_id": types.StringType,
+	"digest":     types.StringType,
+	"region":     types.StringType,
+	"repository": types.StringType,
+	"tag":        types.StringType,
+}
+
+var _ function.Function = ecrImageURIParseFunction{}
+
+func NewECRImageURIParseFunction() function.Function {
+	return &ecrImageURIParseFunction{}
+}
+
+type ecrImageURIParseFunction struct{}
+
+func (f ecrImageURIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_parse"
+}
+
+func (f ecrImageURIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_image_uri_parse Function",
+		MarkdownDescription: "Parses an Amazon ECR container image URI into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "Container image URI (account.dkr.ecr.region.amazonaws.com/repository[:tag][@digest]) to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ecrImageURIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f ecrImageURIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := parseECRImageURI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"account_id": types.StringValue(uri.accountID),
+		"digest":     types.StringValue(uri.digest),
+		"region":     types.StringValue(uri.region),
+		"repository": types.StringValue(uri.repository),
+		"tag":        types.StringValue(uri.tag),
+	}
+
+	result, d := types.ObjectValue(ecrImageURIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ecrImageURI is a parsed Amazon ECR container image URI.
+type ecrImageURI struct {
+	accountID  string
+	digest     string
+	region     string
+	repository string
+	tag        string
+}
+
+func parseECRImageURI(v string) (ecrImageURI, error) {
+	m := ecrImageURIRegexp.FindStringSubmatch(v)
+	if m == nil {
+		return ecrImageURI{}, fmt.Errorf("invalid Amazon ECR image URI (%s): expected account.dkr.ecr.region.domain/repository[:tag][@digest]", v)
+	}
+
+	uri := ecrImageURI{
+		accountID:  m[1],
+		region:     m[2],
+		repository: m[4],
+		tag:        m[5],
+		digest:     m[6],
+	}
+
+	// The registry's domain must be that of the Region's partition.
+	if got, want := m[3], conns.DNSSuffixForRegion(uri.region); got != want {
+		return ecrImageURI{}, fmt.Errorf("invalid Amazon ECR image URI (%s): domain %q does not match Region %s (%s)", v, got, uri.region, want)
+	}
+
+	return uri, nil
+}
+
+func (uri ecrImageURI) String() string {
+	s := fmt.Sprintf("%s.dkr.ecr.%s.%s/%s", uri.accountID, uri.region, conns.DNSSuffixForRegion(uri.region), uri.repository)
+	if uri.tag != "" {
+		s += ":" + uri.tag
+	}
+	if uri.digest != "" {
+		s += "@" + uri.digest
+	}
+
+	return s
+}
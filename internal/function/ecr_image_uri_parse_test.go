@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageURIParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.us-west-2.amazonaws.com/team/app:v1.2@" + digest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("account_id", "444455556666"),
+					resource.TestCheckOutput("region", "us-west-2"),
+					resource.TestCheckOutput("repository", "team/app"),
+					resource.TestCheckOutput("tag", "v1.2"),
+					resource.TestCheckOutput("digest", digest),
+				),
+			},
+			{
+				Config: testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.cn-north-1.amazonaws.com.cn/app"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("region", "cn-north-1"),
+					resource.TestCheckOutput("repository", "app"),
+					resource.TestCheckOutput("tag", ""),
+					resource.TestCheckOutput("digest", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIParseFunctionConfig("public.ecr.aws/example/app:latest"),
+				ExpectError: regexache.MustCompile("invalid Amazon ECR image URI"),
+			},
+			{
+				Config:      testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.cn-north-1.amazonaws.com/app"),
+				ExpectError: regexache.MustCompile("does not match Region"),
+			},
+		},
+	})
+}
+
+func testECRImageURIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::ecr_image_uri_parse(%[1]q)
+}
+
+output "account_id" {
+  value = local.result.account_id
+}
+
+output "region" {
+  value = local.result.region
+}
+
+output "repository" {
+  value = local.result.repository
+}
+
+output "tag" {
+  value = local.result.tag
+}
+
+output "digest" {
+  value = local.result.digest
+}
+`, arg)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEqualFunction{}
+
+func NewIAMPolicyEqualFunction() function.Function {
+	return &iamPolicyEqualFunction{}
+}
+
+type iamPolicyEqualFunction struct{}
+
+func (f iamPolicyEqualFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equal"
+}
+
+func (f iamPolicyEqualFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equal Function",
+		MarkdownDescription: "Returns whether two IAM policy documents are semantically equivalent, as determined by " +
+			"the provider when suppressing differences in policy arguments.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy1",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+			function.StringParameter{
+				Name:                "policy2",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEqualFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy1, policy2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy1, &policy2))
+	if resp.Error != nil {
+		return
+	}
+
+	// Invalid policy documents are errors rather than not equivalent.
+	// Empty policy documents are equivalent.
+	var normalized [2]string
+	for i, v := range []string{policy1, policy2} {
+		if strings.TrimSpace(v) == "" {
+			v = "{}"
+		}
+
+		result, err := normalizeIAMPolicy(v)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+			continue
+		}
+		normalized[i] = result
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(normalized[0], normalized[1])))
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document into a canonical JSON form. Statements and the elements of " +
+			"action, resource, principal and condition value lists are sorted, single-element lists are collapsed, " +
+			"a wildcard principal is written as {\"AWS\":\"*\"} and empty top-level document and statement fields are removed. " +
+			"Policy documents with the same normalized form are equivalent according to iam_policy_equal.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizeIAMPolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizeIAMPolicy returns the canonical JSON form of an IAM policy document.
+// Two policy documents are equivalent if their canonical forms are equal.
+func normalizeIAMPolicy(s string) (string, error) {
+	var policy any
+
+	// Preserve numeric values exactly.
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&policy); err != nil {
+		return "", fmt.Errorf("decoding policy: %w", err)
+	}
+
+	document, ok := policy.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("policy must be a JSON object")
+	}
+
+	// Maps are encoded with sorted keys.
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(normalizeIAMPolicyDocument(document)); err != nil {
+		return "", fmt.Errorf("encoding policy: %w", err)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// normalizeIAMPolicyDocument normalizes the top-level elements of a policy document.
+func normalizeIAMPolicyDocument(document map[string]any) map[string]any {
+	m := withoutEmptyIAMPolicyValues(document)
+
+	switch v := m["Statement"].(type) {
+	case map[string]any:
+		m["Statement"] = normalizeIAMPolicyStatement(v)
+	case []any:
+		statements := make([]any, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				statements = append(statements, normalizeIAMPolicyStatement(v))
+			} else {
+				statements = append(statements, v)
+			}
+		}
+
+		// Statement order is not significant.
+		sortIAMPolicyValues(statements)
+
+		if len(statements) == 1 {
+			m["Statement"] = statements[0]
+		} else {
+			m["Statement"] = statements
+		}
+	}
+
+	return m
+}
+
+// normalizeIAMPolicyStatement normalizes the top-level elements of a policy statement.
+// The canonicalization matches the provider's policy equivalence (verify.PolicyStringsEquivalent).
+func normalizeIAMPolicyStatement(statement map[string]any) map[string]any {
+	m := withoutEmptyIAMPolicyValues(statement)
+
+	for k, v := range m {
+		switch k {
+		case "Action", "NotAction", "Resource", "NotResource":
+			m[k] = normalizeIAMPolicySet(v)
+		case "Principal", "NotPrincipal":
+			switch v := v.(type) {
+			case string:
+				// "*" is equivalent to {"AWS":"*"}.
+				if v == "*" {
+					m[k] = map[string]any{"AWS": v}
+				}
+			case map[string]any:
+				principals := make(map[string]any, len(v))
+				for k, v := range v {
+					principals[k] = normalizeIAMPolicySet(v)
+				}
+				m[k] = principals
+			}
+		case "Condition":
+			if v, ok := v.(map[string]any); ok {
+				m[k] = normalizeIAMPolicyCondition(v)
+			}
+		}
+	}
+
+	return m
+}
+
+// normalizeIAMPolicyCondition normalizes a statement's Condition block.
+// Condition values are compared as strings and their order is not significant.
+// Empty condition values are significant and are not removed.
+func normalizeIAMPolicyCondition(condition map[string]any) map[string]any {
+	m := make(map[string]any, len(condition))
+
+	for operator, v := range condition {
+		keys, ok := v.(map[string]any)
+		if !ok {
+			m[operator] = v
+			continue
+		}
+
+		values := make(map[string]any, len(keys))
+		for k, v := range keys {
+			if s, ok := v.([]any); ok {
+				s = slices.Clone(s)
+				for i, v := range s {
+					s[i] = iamPolicyConditionValueString(v)
+				}
+				values[k] = normalizeIAMPolicySet(s)
+			} else {
+				values[k] = iamPolicyConditionValueString(v)
+			}
+		}
+		m[operator] = values
+	}
+
+	return m
+}
+
+// iamPolicyConditionValueString returns the string form of a scalar condition value.
+func iamPolicyConditionValueString(v any) any {
+	switch v := v.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// normalizeIAMPolicySet normalizes an element whose value is a set of strings.
+// Element order is not significant and duplicates are redundant.
+func normalizeIAMPolicySet(v any) any {
+	s, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	s = slices.Clone(s)
+	sortIAMPolicyValues(s)
+	s = slices.CompactFunc(s, func(a, b any) bool {
+		return encodeIAMPolicyValue(a) == encodeIAMPolicyValue(b)
+	})
+
+	if len(s) == 1 {
+		return s[0]
+	}
+
+	return s
+}
+
+// withoutEmptyIAMPolicyValues returns a copy of the specified policy element without empty values.
+func withoutEmptyIAMPolicyValues(v map[string]any) map[string]any {
+	m := make(map[string]any, len(v))
+	for k, v := range v {
+		if !isEmptyIAMPolicyValue(v) {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+// isEmptyIAMPolicyValue returns whether the specified policy element is empty.
+func isEmptyIAMPolicyValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// sortIAMPolicyValues sorts policy elements on their canonical encoding.
+func sortIAMPolicyValues(s []any) {
+	slices.SortFunc(s, func(a, b any) int {
+		return strings.Compare(encodeIAMPolicyValue(a), encodeIAMPolicyValue(b))
+	})
+}
+
+func encodeIAMPolicyValue(v any) string {
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_build Function",
+		MarkdownDescription: "Builds an S3 URI from a bucket name or access point ARN and an object key",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name or S3 access point ARN",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Object key or key prefix. May be null or empty",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket string
+	var key types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	result := s3URIScheme + bucket
+	if v := key.ValueString(); v != "" {
+		result += "/" + v
+	}
+
+	// Ensure that the result can be parsed.
+	if _, err := parseS3URI(ctx, result); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIBuildFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::s3_uri_build("example-bucket", "path/to/object.txt")
+}
+
+output "no_key" {
+  value = provider::aws::s3_uri_build("example-bucket", null)
+}
+
+output "access_point" {
+  value = provider::aws::s3_uri_build("arn:aws:s3:us-west-2:444455556666:accesspoint/example", "object.txt")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://example-bucket/path/to/object.txt"),
+					resource.TestCheckOutput("no_key", "s3://example-bucket"),
+					resource.TestCheckOutput("access_point", "s3://arn:aws:s3:us-west-2:444455556666:accesspoint/example/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::s3_uri_build("Invalid_Bucket", "object.txt")
+}
+`,
+				ExpectError: regexache.MustCompile("invalid S3 URI"),
+			},
+		},
+	})
+}
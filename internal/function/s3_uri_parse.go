@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+const (
+	s3URIScheme = "s3://"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"access_point_name": types.StringType,
+	"account_id":        types.StringType,
+	"bucket":            types.StringType,
+	"key":               types.StringType,
+	"partition":         types.StringType,
+	"region":            types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI, S3 access point ARN or S3 object ARN into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI (s3://bucket/key or s3://access-point-arn/key), S3 access point ARN or S3 object ARN to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := parseS3URI(ctx, arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"access_point_name": types.StringValue(uri.accessPointName),
+		"account_id":        types.StringValue(uri.accountID),
+		"bucket":            types.StringValue(uri.bucket),
+		"key":               types.StringValue(uri.key),
+		"partition":         types.StringValue(uri.partition),
+		"region":            types.StringValue(uri.region),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// s3URI is a parsed S3 URI.
+// For an access point, bucket is the access point ARN, which S3 APIs accept in place of a bucket name.
+type s3URI struct {
+	accessPointName string
+	accountID       string
+	bucket          string
+	key             string
+	partition       string
+	region          string
+}
+
+func parseS3URI(ctx context.Context, v string) (s3URI, error) {
+	if arn.IsARN(v) {
+		return parseS3ARN(v)
+	}
+
+	rest, ok := strings.CutPrefix(v, s3URIScheme)
+	if !ok {
+		return s3URI{}, fmt.Errorf("invalid S3 URI (%s): expected %q prefix or an ARN", v, s3URIScheme)
+	}
+
+	// s3://arn:aws:s3:us-west-2:123456789012:accesspoint/name[/key]
+	if arn.IsARN(rest) {
+		a, err := arn.Parse(rest)
+		if err != nil {
+			return s3URI{}, fmt.Errorf("invalid S3 URI (%s): %w", v, err)
+		}
+
+		name, key, ok := strings.Cut(strings.TrimPrefix(a.Resource, "accesspoint/"), "/")
+		if a.Service != "s3" || !strings.HasPrefix(a.Resource, "accesspoint/") || name == "" || (ok && key == "") {
+			return s3URI{}, fmt.Errorf("invalid S3 URI (%s): expected an S3 access point ARN", v)
+		}
+
+		return newS3AccessPointURI(a, name, key), nil
+	}
+
+	request := validator.StringRequest{
+		Path:        path.Root("uri"),
+		ConfigValue: types.StringValue(v),
+	}
+	var response validator.StringResponse
+	fwvalidators.S3URI().ValidateString(ctx, request, &response)
+	if response.Diagnostics.HasError() {
+		return s3URI{}, fmt.Errorf("invalid S3 URI (%s): expected s3://bucket[/key]", v)
+	}
+
+	bucket, key, _ := strings.Cut(rest, "/")
+
+	return s3URI{
+		bucket: bucket,
+		key:    key,
+	}, nil
+}
+
+// parseS3ARN parses an S3 access point ARN, optionally with an object key, or an S3 bucket or object ARN.
+func parseS3ARN(v string) (s3URI, error) {
+	a, err := arn.Parse(v)
+	if err != nil {
+		return s3URI{}, err
+	}
+
+	if a.Service != "s3" {
+		return s3URI{}, fmt.Errorf("invalid S3 ARN (%s): unexpected service %q", v, a.Service)
+	}
+
+	// arn:aws:s3:us-west-2:123456789012:accesspoint/name[/object/key]
+	if rest, ok := strings.CutPrefix(a.Resource, "accesspoint/"); ok {
+		name, object, ok := strings.Cut(rest, "/")
+		key, isObject := strings.CutPrefix(object, "object/")
+		if name == "" || (ok && (!isObject || key == "")) {
+			return s3URI{}, fmt.Errorf("invalid S3 access point ARN (%s)", v)
+		}
+
+		return newS3AccessPointURI(a, name, key), nil
+	}
+
+	// arn:aws:s3:::bucket[/key]
+	if a.Region != "" || a.AccountID != "" {
+		return s3URI{}, fmt.Errorf("invalid S3 ARN (%s): expected an access point, bucket or object ARN", v)
+	}
+
+	bucket, key, _ := strings.Cut(a.Resource, "/")
+	if bucket == "" {
+		return s3URI{}, fmt.Errorf("invalid S3 ARN (%s): no bucket", v)
+	}
+
+	return s3URI{
+		bucket:    bucket,
+		key:       key,
+		partition: a.Partition,
+	}, nil
+}
+
+func newS3AccessPointURI(a arn.ARN, name, key string) s3URI {
+	return s3URI{
+		accessPointName: name,
+		accountID:       a.AccountID,
+		bucket: arn.ARN{
+			Partition: a.Partition,
+			Service:   a.Service,
+			Region:    a.Region,
+			AccountID: a.AccountID,
+			Resource:  "accesspoint/" + name,
+		}.String(),
+		key:       key,
+		partition: a.Partition,
+		region:    a.Region,
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_bucket(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example-bucket/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "example-bucket"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("access_point_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_accessPoint(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://arn:aws-cn:s3:cn-north-1:444455556666:accesspoint/example/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "arn:aws-cn:s3:cn-north-1:444455556666:accesspoint/example"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("access_point_name", "example"),
+					resource.TestCheckOutput("partition", "aws-cn"),
+				),
+			},
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3:us-west-2:444455556666:accesspoint/example/object/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "arn:aws:s3:us-west-2:444455556666:accesspoint/example"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("access_point_name", "example"),
+					resource.TestCheckOutput("partition", "aws"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_objectARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws-us-gov:s3:::example-bucket/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "example-bucket"),
+					resource.TestCheckOutput("key", "object.txt"),
+					resource.TestCheckOutput("partition", "aws-us-gov"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example-bucket.s3.amazonaws.com/object.txt"),
+				ExpectError: regexache.MustCompile("invalid S3 URI"),
+			},
+			{
+				Config:      testS3URIParseFunctionConfig("arn:aws:iam::444455556666:role/example"),
+				ExpectError: regexache.MustCompile("invalid S3 ARN"),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "access_point_name" {
+  value = local.result.access_point_name
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "key" {
+  value = local.result.key
+}
+
+output "partition" {
+  value = local.result.partition
+}
+`, arg)
+}
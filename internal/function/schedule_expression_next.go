@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+const (
+	scheduleExpressionNextMaxCount = 100
+)
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_next Function",
+		MarkdownDescription: "Computes the next times, after a start time, at which an `at(...)`, `cron(...)` or `rate(...)` schedule expression fires. " +
+			"Times are returned in RFC3339 format in the specified time zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone name, e.g. America/New_York, in which the expression is evaluated. Defaults to UTC if null or empty",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC3339 timestamp after which fire times are computed, e.g. the result of timestamp()",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of fire times to return, between 1 and %d", scheduleExpressionNextMaxCount),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, start string
+	var timezone types.String
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &start, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := scheduleExpressionNext(expression, timezone.ValueString(), start, count)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func scheduleExpressionNext(expression, timezone, start string, count int64) ([]string, error) {
+	if count < 1 || count > scheduleExpressionNextMaxCount {
+		return nil, fmt.Errorf("count (%d) must be between 1 and %d", count, scheduleExpressionNextMaxCount)
+	}
+
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone (%s): %w", timezone, err)
+		}
+	}
+
+	from, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time (%s): %w", start, err)
+	}
+
+	e, err := schedule.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule expression (%s): %w", expression, err)
+	}
+
+	result := make([]string, 0, count)
+	for _, v := range e.Next(from, loc, int(count)) {
+		result = append(result, v.Format(time.RFC3339))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 ? * MON-FRI *)", "null", "2025-03-07T10:30:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-03-10T09:00:00Z,2025-03-11T09:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_timezone(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(30 2 * * ? *)", `"America/New_York"`, "2025-03-07T10:30:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-03-08T02:30:00-05:00,2025-03-10T02:30:00-04:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(2 hours)", "null", "2025-03-07T10:30:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-03-07T12:30:00Z,2025-03-07T14:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 hours)", "null", "2025-03-07T10:30:00Z", 2),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*schedule[\s\n]*expression`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 hour)", `"Mars/Olympus_Mons"`, "2025-03-07T10:30:00Z", 2),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*time[\s\n]*zone`),
+			},
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 hour)", "null", "2025-03-07T10:30:00Z", 0),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*between[\s\n]*1[\s\n]*and[\s\n]*100`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expression, timezone, start string, count int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_expression_next(%[1]q, %[2]s, %[3]q, %[4]d))
+}
+`, expression, timezone, start, count)
+}
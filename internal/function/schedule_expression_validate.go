@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+var scheduleExpressionErrorAttrTypes = map[string]attr.Type{
+	"field":   types.StringType,
+	"message": types.StringType,
+}
+
+var scheduleExpressionValidateResultAttrTypes = map[string]attr.Type{
+	"errors": types.ListType{ElemType: types.ObjectType{AttrTypes: scheduleExpressionErrorAttrTypes}},
+	"type":   types.StringType,
+	"valid":  types.BoolType,
+}
+
+var _ function.Function = scheduleExpressionValidateFunction{}
+
+func NewScheduleExpressionValidateFunction() function.Function {
+	return &scheduleExpressionValidateFunction{}
+}
+
+type scheduleExpressionValidateFunction struct{}
+
+func (f scheduleExpressionValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_validate"
+}
+
+func (f scheduleExpressionValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "schedule_expression_validate Function",
+		MarkdownDescription: "Validates an `at(...)`, `cron(...)` or `rate(...)` schedule expression, returning any errors instead of failing",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression to validate",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: scheduleExpressionValidateResultAttrTypes,
+		},
+	}
+}
+
+func (f scheduleExpressionValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	typ := types.StringNull()
+	errs := make([]attr.Value, 0)
+
+	expression, err := schedule.Parse(arg)
+	if err != nil {
+		var fieldErrs schedule.Errors
+		if !errors.As(err, &fieldErrs) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+			return
+		}
+
+		for _, v := range fieldErrs {
+			errs = append(errs, types.ObjectValueMust(scheduleExpressionErrorAttrTypes, map[string]attr.Value{
+				"field":   types.StringValue(v.Field),
+				"message": types.StringValue(v.Message),
+			}))
+		}
+	} else {
+		typ = types.StringValue(string(expression.Type()))
+	}
+
+	value := map[string]attr.Value{
+		"errors": types.ListValueMust(types.ObjectType{AttrTypes: scheduleExpressionErrorAttrTypes}, errs),
+		"type":   typ,
+		"valid":  types.BoolValue(err == nil),
+	}
+
+	result, d := types.ObjectValue(scheduleExpressionValidateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
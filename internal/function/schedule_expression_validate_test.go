@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  result = provider::aws::schedule_expression_validate("cron(0/15 8-17 ? * MON-FRI *)")
+}
+
+output "valid" {
+  value = local.result.valid
+}
+
+output "type" {
+  value = local.result.type
+}
+
+output "errors" {
+  value = length(local.result.errors)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", acctest.CtTrue),
+					resource.TestCheckOutput("type", "cron"),
+					resource.TestCheckOutput("errors", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionValidateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  result = provider::aws::schedule_expression_validate("cron(60 24 * * MON *)")
+}
+
+output "valid" {
+  value = local.result.valid
+}
+
+output "type" {
+  value = coalesce(local.result.type, "none")
+}
+
+output "fields" {
+  value = join(",", local.result.errors[*].field)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", acctest.CtFalse),
+					resource.TestCheckOutput("type", "none"),
+					resource.TestCheckOutput("fields", "minutes,hours,day-of-week"),
+				),
+			},
+		},
+	})
+}
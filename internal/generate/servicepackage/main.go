@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -61,6 +62,7 @@ func main() {
 			ephemeralResources:   make(map[string]ResourceDatum, 0),
 			frameworkDataSources: make(map[string]ResourceDatum, 0),
 			frameworkResources:   make(map[string]ResourceDatum, 0),
+			listResources:        make(map[string]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
@@ -80,6 +82,7 @@ func main() {
 			EphemeralResources:      v.ephemeralResources,
 			FrameworkDataSources:    v.frameworkDataSources,
 			FrameworkResources:      v.frameworkResources,
+			ListResources:           v.listResources,
 			SDKDataSources:          v.sdkDataSources,
 			SDKResources:            v.sdkResources,
 		}
@@ -120,6 +123,13 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	TagsAll                 bool
+	IdentityAttributes      []IdentityAttribute
+}
+
+type IdentityAttribute struct {
+	Name     string
+	Optional bool
 }
 
 type ServiceDatum struct {
@@ -131,6 +141,7 @@ type ServiceDatum struct {
 	EphemeralResources      map[string]ResourceDatum
 	FrameworkDataSources    map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
+	ListResources           map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
 }
@@ -158,6 +169,7 @@ type visitor struct {
 	ephemeralResources   map[string]ResourceDatum
 	frameworkDataSources map[string]ResourceDatum
 	frameworkResources   map[string]ResourceDatum
+	listResources        map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
@@ -223,6 +235,39 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+
+			if attr, ok := args.Keyword["tagsAll"]; ok {
+				tagsAll, err := strconv.ParseBool(attr)
+				if err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid tagsAll value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+				d.TagsAll = tagsAll
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			attr := IdentityAttribute{
+				Name: namesgen.ConstOrQuote(args.Positional[0]),
+			}
+
+			if arg, ok := args.Keyword["optional"]; ok {
+				optional, err := strconv.ParseBool(arg)
+				if err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid optional value (%s): %s: %w", arg, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+				attr.Optional = optional
+			}
+
+			d.IdentityAttributes = append(d.IdentityAttributes, attr)
 		}
 	}
 
@@ -308,6 +353,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkResources[typeName] = d
 				}
+			case "ListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.listResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.listResources[typeName] = d
+				}
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -354,7 +422,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "IdentityAttribute", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
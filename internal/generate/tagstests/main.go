@@ -392,6 +392,7 @@ type ResourceDatum struct {
 	CheckDestroyNoop                 bool
 	IsDataSource                     bool
 	DataSourceResourceImplementation implementation
+	DataSourceTagsAll                bool
 	overrideIdentifierAttribute      string
 	OverrideResourceType             string
 }
@@ -577,6 +578,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				if _, ok := args.Keyword["identifierAttribute"]; ok {
 					hasIdentifierAttribute = true
 				}
+				if attr, ok := args.Keyword["tagsAll"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid tagsAll value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.DataSourceTagsAll = b
+					}
+				}
 
 			case "Testing":
 				args := common.ParseArgs(m[3])
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	EnvVarLogFormat = "TF_AWS_LOG_FORMAT"
+
+	// LogFormatJSON writes one JSON line per AWS API call to the provider's standard error.
+	LogFormatJSON = "json"
+)
+
+// Structured logging keys set on every AWS SDK for Go v2 API call.
+const (
+	KeyAWSAttempts  = "aws.attempts"
+	KeyAWSErrorCode = "aws.error_code"
+	KeyAWSLatency   = "aws.latency_ms"
+	KeyAWSOperation = "aws.operation"
+	KeyAWSRegion    = "aws.region"
+	KeyAWSRequestID = "aws.request_id"
+	KeyAWSService   = "aws.service"
+	KeyAWSThrottles = "aws.throttles"
+)
+
+const (
+	apiCallMessage = "AWS API call"
+)
+
+var (
+	apiCallLogLock   sync.Mutex
+	apiCallLogWriter io.Writer = os.Stderr
+
+	// logFormat is read once, when the first API call is logged.
+	logFormat = sync.OnceValue(func() string {
+		return os.Getenv(EnvVarLogFormat)
+	})
+)
+
+// APICall is the outcome of an AWS SDK for Go v2 API call, after all attempts.
+type APICall struct {
+	Attempts  int
+	Err       error
+	Latency   time.Duration
+	RequestID string
+	Throttles int
+}
+
+// LogAPICall logs the outcome of an API call with structured fields.
+// The service, operation and Region are read from the API call's Context.
+func LogAPICall(ctx context.Context, call APICall) {
+	fields := apiCallFields(ctx, call)
+
+	if logFormat() == LogFormatJSON {
+		writeAPICallJSON(apiCallLogWriter, fields, call.Err)
+	} else {
+		tflog.Debug(ctx, apiCallMessage, fields)
+	}
+}
+
+// apiCallFields returns the structured logging fields for a completed API call.
+func apiCallFields(ctx context.Context, call APICall) map[string]any {
+	fields := map[string]any{
+		KeyAWSLatency:   call.Latency.Milliseconds(),
+		KeyAWSOperation: awsmiddleware.GetOperationName(ctx),
+		KeyAWSRegion:    awsmiddleware.GetRegion(ctx),
+		KeyAWSService:   awsmiddleware.GetServiceID(ctx),
+	}
+
+	if call.RequestID != "" {
+		fields[KeyAWSRequestID] = call.RequestID
+	}
+
+	if call.Attempts > 0 {
+		fields[KeyAWSAttempts] = call.Attempts
+		fields[KeyAWSThrottles] = call.Throttles
+	}
+
+	if call.Err != nil {
+		var apiErr smithy.APIError
+		if errors.As(call.Err, &apiErr) {
+			fields[KeyAWSErrorCode] = apiErr.ErrorCode()
+		}
+	}
+
+	return fields
+}
+
+// writeAPICallJSON writes an API call's fields as a single JSON line.
+// The `@`-prefixed keys follow the hclog JSON format so that Terraform logs the line at the correct level.
+func writeAPICallJSON(w io.Writer, fields map[string]any, err error) {
+	line := map[string]any{
+		"@level":     "info",
+		"@message":   apiCallMessage,
+		"@timestamp": time.Now().Format(time.RFC3339Nano),
+	}
+	if err != nil {
+		line["@level"] = "warn"
+		line["error"] = err.Error()
+	}
+	for k, v := range fields {
+		line[k] = v
+	}
+
+	b, jsonErr := json.Marshal(line)
+	if jsonErr != nil {
+		return
+	}
+
+	apiCallLogLock.Lock()
+	defer apiCallLogLock.Unlock()
+
+	_, _ = w.Write(append(b, '\n'))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+)
+
+func TestAPICallFields(t *testing.T) {
+	t.Parallel()
+
+	ctx := awsmiddleware.SetServiceID(context.Background(), "IAM")
+	err := &smithy.GenericAPIError{Code: "NoSuchEntity", Message: "not found"}
+
+	fields := apiCallFields(ctx, APICall{
+		Attempts:  3,
+		Err:       err,
+		Latency:   1500 * time.Millisecond,
+		RequestID: "request-1",
+		Throttles: 2,
+	})
+
+	for key, want := range map[string]any{
+		KeyAWSAttempts:  3,
+		KeyAWSErrorCode: "NoSuchEntity",
+		KeyAWSLatency:   int64(1500),
+		KeyAWSRequestID: "request-1",
+		KeyAWSService:   "IAM",
+		KeyAWSThrottles: 2,
+	} {
+		if got := fields[key]; got != want {
+			t.Errorf("%s: got %v, want %v", key, got, want)
+		}
+	}
+
+	fields = apiCallFields(ctx, APICall{})
+
+	for _, key := range []string{KeyAWSAttempts, KeyAWSErrorCode, KeyAWSRequestID, KeyAWSThrottles} {
+		if _, ok := fields[key]; ok {
+			t.Errorf("unexpected %s", key)
+		}
+	}
+}
+
+func TestWriteAPICallJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	writeAPICallJSON(&buf, map[string]any{KeyAWSOperation: "GetRole", KeyAWSAttempts: 2}, errors.New("throttled"))
+	writeAPICallJSON(&buf, map[string]any{KeyAWSOperation: "GetRole"}, nil)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+
+	for i, want := range []map[string]any{
+		{"@level": "warn", "@message": apiCallMessage, KeyAWSOperation: "GetRole", KeyAWSAttempts: float64(2), "error": "throttled"},
+		{"@level": "info", "@message": apiCallMessage, KeyAWSOperation: "GetRole"},
+	} {
+		var got map[string]any
+		if err := json.Unmarshal(lines[i], &got); err != nil {
+			t.Fatalf("line %d: %s", i, err)
+		}
+		if _, ok := got["@timestamp"]; !ok {
+			t.Errorf("line %d: missing @timestamp", i)
+		}
+		delete(got, "@timestamp")
+
+		if len(got) != len(want) {
+			t.Errorf("line %d: got %v, want %v", i, got, want)
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("line %d: %s: got %v, want %v", i, k, got[k], v)
+			}
+		}
+	}
+}
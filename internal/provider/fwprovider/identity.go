@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateIdentity ensures that each identity attribute is a string attribute defined in the specified resource schema.
+// The provider-managed `region` attribute is not part of the inner schema.
+func validateIdentity(ctx context.Context, identity *itypes.ServicePackageResourceIdentity, s resourceschema.Schema, isRegional bool) error {
+	for i, attr := range identity.Attributes {
+		if i == 0 && attr.Optional {
+			return fmt.Errorf("first identity attribute `%s` cannot be optional", attr.Name)
+		}
+
+		if attr.Name == names.AttrRegion && isRegional {
+			continue
+		}
+
+		v, ok := s.Attributes[attr.Name]
+		if !ok {
+			return fmt.Errorf("identity attribute `%s` not defined in schema", attr.Name)
+		}
+		if !v.GetType().TerraformType(ctx).Is(tftypes.String) {
+			return fmt.Errorf("identity attribute `%s` must be a string", attr.Name)
+		}
+	}
+
+	return nil
+}
+
+// identitySchema returns the identity schema for the specified identity.
+func identitySchema(identity *itypes.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(identity.Attributes))
+
+	for _, attr := range identity.Attributes {
+		attributes[attr.Name] = identityschema.StringAttribute{
+			RequiredForImport: !attr.Optional,
+			OptionalForImport: attr.Optional,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// setIdentity sets the identity's attributes from the specified state.
+func setIdentity(ctx context.Context, identity *itypes.ServicePackageResourceIdentity, state tfsdk.State, target *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if target == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	for _, attr := range identity.Attributes {
+		var v *string
+		diags.Append(state.GetAttribute(ctx, path.Root(attr.Name), &v)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(target.SetAttribute(ctx, path.Root(attr.Name), v)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// importIdentityValues returns the values of the identity's attributes that are set in the specified identity data.
+// The first identity attribute must be set.
+func importIdentityValues(ctx context.Context, identity *itypes.ServicePackageResourceIdentity, data tfsdk.ResourceIdentity) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := make(map[string]string, len(identity.Attributes))
+
+	for i, attr := range identity.Attributes {
+		var v *string
+		diags.Append(data.GetAttribute(ctx, path.Root(attr.Name), &v)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v == nil || *v == "" {
+			if i == 0 {
+				diags.AddAttributeError(path.Root(attr.Name), "Missing Identity Attribute", fmt.Sprintf("The identity attribute %q must be set when importing by identity.", attr.Name))
+				return nil, diags
+			}
+			continue
+		}
+
+		values[attr.Name] = *v
+	}
+
+	return values, diags
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -46,12 +47,26 @@ func (s dataSourceInterceptors) read() []dataSourceInterceptorReadFunc {
 	})
 }
 
+// An ephemeral resource interceptor is functionality invoked during the ephemeral resource's request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+// In other cases all interceptors in the chain are run.
 type ephemeralResourceInterceptor interface {
-	// TODO implement me
+	// open is invoked for an Open call.
+	open(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type ephemeralResourceInterceptors []ephemeralResourceInterceptor
 
+type ephemeralResourceInterceptorOpenFunc interceptorFunc[ephemeral.OpenRequest, ephemeral.OpenResponse]
+
+// open returns a slice of interceptors that run on ephemeral resource Open.
+func (s ephemeralResourceInterceptors) open() []ephemeralResourceInterceptorOpenFunc {
+	return slices.ApplyToAll(s, func(e ephemeralResourceInterceptor) ephemeralResourceInterceptorOpenFunc {
+		return e.open
+	})
+}
+
 type resourceCRUDRequest interface {
 	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
 }
@@ -158,6 +173,45 @@ func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFu
 	}
 }
 
+// interceptedEphemeralResourceOpenHandler returns a handler that invokes the specified ephemeral resource Open handler, running any interceptors.
+func interceptedEphemeralResourceOpenHandler(interceptors []ephemeralResourceInterceptorOpenFunc, f func(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse) diag.Diagnostics {
+	return func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+		var diags diag.Diagnostics
+		// Before interceptors are run first to last.
+		forward := interceptors
+
+		when := Before
+		for _, v := range forward {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		// All other interceptors are run last to first.
+		reverse := slices.Reverse(forward)
+		diags = f(ctx, request, response)
+
+		if diags.HasError() {
+			when = OnError
+		} else {
+			when = After
+		}
+		for _, v := range reverse {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+		}
+
+		when = Finally
+		for _, v := range reverse {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+		}
+
+		return diags
+	}
+}
+
 // interceptedResourceHandler returns a handler that invokes the specified resource CRUD handler, running any interceptors.
 func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
@@ -197,6 +251,86 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 	}
 }
 
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if region := regionString(request.Config.Raw); region != "" {
+			setOverrideRegion(ctx, region)
+		}
+	case After:
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if region := regionString(request.Plan.Raw); region != "" {
+			setOverrideRegion(ctx, region)
+		}
+	case After:
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if region := regionString(request.State.Raw); region != "" {
+			setOverrideRegion(ctx, region)
+		}
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if region := regionString(request.Plan.Raw); region != "" {
+			setOverrideRegion(ctx, region)
+		}
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if region := regionString(request.State.Raw); region != "" {
+			setOverrideRegion(ctx, region)
+		}
+	}
+
+	return ctx, diags
+}
+
 // tagsDataSourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -207,73 +341,113 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 		return ctx, diags
 	}
 
-	inContext, ok := conns.FromContext(ctx)
-	if !ok {
-		return ctx, diags
-	}
+	switch when {
+	case Before:
+		diags.Append(setTagsIn(ctx, request.Config)...)
+	case After:
+		// Will occur if the data source's R handler removed the resource, e.g. when not found.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
 
-	sp := meta.ServicePackage(ctx, inContext.ServicePackageName)
-	if sp == nil {
-		return ctx, diags
+		diags.Append(setComputedTags(ctx, meta, r.tags, &response.State)...)
 	}
 
-	serviceName, err := names.HumanFriendly(sp.ServicePackageName())
-	if err != nil {
-		serviceName = "<service>"
-	}
+	return ctx, diags
+}
 
-	resourceName := inContext.ResourceName
-	if resourceName == "" {
-		resourceName = "<thing>"
-	}
+// tagsEphemeralResourceInterceptor implements transparent tagging for ephemeral resources.
+type tagsEphemeralResourceInterceptor struct {
+	tags *types.ServicePackageResourceTags
+}
 
-	tagsInContext, ok := tftags.FromContext(ctx)
-	if !ok {
+func (r tagsEphemeralResourceInterceptor) open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil {
 		return ctx, diags
 	}
 
 	switch when {
 	case Before:
-		var configTags tftags.Map
-		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
-		if diags.HasError() {
+		diags.Append(setTagsIn(ctx, request.Config)...)
+	case After:
+		if response.Result.Raw.IsNull() {
 			return ctx, diags
 		}
 
-		tags := tftags.New(ctx, configTags)
+		diags.Append(setComputedTags(ctx, meta, r.tags, &response.Result)...)
+	}
 
-		tagsInContext.TagsIn = option.Some(tags)
+	return ctx, diags
+}
 
-	case After:
-		// If the R handler didn't set tags, try and read them from the service API.
-		if tagsInContext.TagsOut.IsNone() {
-			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
-				var identifier string
-				diags.Append(response.State.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
-				if diags.HasError() {
-					return ctx, diags
-				}
+// attributeGetter is implemented by the Plugin Framework's configuration and state values.
+type attributeGetter interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
 
-				// If the service package has a generic resource list tags methods, call it.
-				var err error
-				if v, ok := sp.(interface {
-					ListTags(context.Context, any, string) error
-				}); ok {
-					err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
-				} else if v, ok := sp.(interface {
-					ListTags(context.Context, any, string, string) error
-				}); ok && r.tags.ResourceType != "" {
-					err = v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
-				} else {
-					tflog.Warn(ctx, "No ListTags method found", map[string]interface{}{
-						"ServicePackage": sp.ServicePackageName(),
-						"ResourceType":   r.tags.ResourceType,
-					})
-				}
+// attributeGetterSetter is implemented by the Plugin Framework's state and ephemeral result values.
+type attributeGetterSetter interface {
+	attributeGetter
+	SetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
+
+// setTagsIn sets the tags in Context from any configured `tags` value.
+func setTagsIn(ctx context.Context, config attributeGetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var configTags tftags.Map
+	diags.Append(config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tagsInContext.TagsIn = option.Some(tftags.New(ctx, configTags))
+
+	return diags
+}
+
+// setComputedTags sets the Computed `tags` attribute of a data source or ephemeral resource from the tags in Context,
+// reading them from the service API if the R handler didn't set them.
+// Any provider configured ignore_tags and system tags are removed.
+// If the data source or ephemeral resource has opted in, the Computed `tags_all` attribute also includes any provider configured default_tags.
+func setComputedTags(ctx context.Context, meta *conns.AWSClient, resourceTags *types.ServicePackageResourceTags, data attributeGetterSetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	sp := meta.ServicePackage(ctx, inContext.ServicePackageName)
+	if sp == nil {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	// If the R handler didn't set tags, try and read them from the service API.
+	if tagsInContext.TagsOut.IsNone() {
+		if identifierAttribute := resourceTags.IdentifierAttribute; identifierAttribute != "" {
+			var identifier string
+			diags.Append(data.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if identifier != "" {
+				err := listTags(ctx, sp, meta, resourceTags, identifier) // Sets tags in Context
 
 				// ISO partitions may not support tagging, giving error.
 				if errs.IsUnsupportedOperationInPartitionError(meta.Partition(ctx), err) {
-					return ctx, diags
+					return diags
 				}
 
 				if sp.ServicePackageName() == names.DynamoDB && err != nil {
@@ -284,24 +458,75 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 				}
 
 				if err != nil {
-					diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
-					return ctx, diags
+					diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", humanFriendlyServiceName(sp), humanFriendlyResourceName(inContext), identifier), err.Error())
+					return diags
 				}
 			}
 		}
+	}
 
-		tags := tagsInContext.TagsOut.UnwrapOrDefault()
+	apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
-		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-		stateTags := flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
-		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), tftags.NewMapFromMapValue(stateTags))...)
+	// Remove any provider configured ignore_tags and system tags from those returned from the service API.
+	stateTags := flex.FlattenFrameworkStringValueMapLegacy(ctx, apiTags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
+	diags.Append(data.SetAttribute(ctx, path.Root(names.AttrTags), tftags.NewMapFromMapValue(stateTags))...)
+	if diags.HasError() {
+		return diags
+	}
 
+	if resourceTags.TagsAll {
+		// Computed tags_all include any provider configured default_tags not returned from the service API.
+		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.DefaultConfig.MergeTags(apiTags).IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(tagsInContext.IgnoreConfig).Map())
+		diags.Append(data.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.NewMapFromMapValue(stateTagsAll))...)
 		if diags.HasError() {
-			return ctx, diags
+			return diags
 		}
 	}
 
-	return ctx, diags
+	return diags
+}
+
+func humanFriendlyServiceName(sp conns.ServicePackage) string {
+	serviceName, err := names.HumanFriendly(sp.ServicePackageName())
+	if err != nil {
+		return "<service>"
+	}
+
+	return serviceName
+}
+
+func humanFriendlyResourceName(inContext *conns.InContext) string {
+	if inContext.ResourceName == "" {
+		return "<thing>"
+	}
+
+	return inContext.ResourceName
+}
+
+// listTags calls the service package's generic list tags method, which sets tags in Context.
+func listTags(ctx context.Context, sp conns.ServicePackage, meta *conns.AWSClient, resourceTags *types.ServicePackageResourceTags, identifier string) error {
+	if v, ok := sp.(tftags.ServiceTagLister); ok {
+		return v.ListTags(ctx, meta, identifier)
+	}
+
+	if v, ok := sp.(tftags.ResourceTypeTagLister); ok {
+		if resourceTags.ResourceType == "" {
+			tflog.Error(ctx, "ListTags method requires ResourceType but none set", map[string]interface{}{
+				"ServicePackage": sp.ServicePackageName(),
+			})
+
+			return nil
+		}
+
+		return v.ListTags(ctx, meta, identifier, resourceTags.ResourceType)
+	}
+
+	tflog.Warn(ctx, "No ListTags method found", map[string]interface{}{
+		"ServicePackage": sp.ServicePackageName(),
+		"ResourceType":   resourceTags.ResourceType,
+	})
+
+	return nil
 }
 
 // tagsResourceInterceptor implements transparent tagging for resources.
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testTagsServicePackage is a service package whose ListTags method returns fixed tags.
+type testTagsServicePackage struct {
+	conns.ServicePackage
+
+	tags        map[string]string
+	identifiers []string // Identifiers passed to ListTags.
+}
+
+func (sp *testTagsServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (sp *testTagsServicePackage) ListTags(ctx context.Context, _ any, identifier string) error {
+	sp.identifiers = append(sp.identifiers, identifier)
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, sp.tags))
+	}
+
+	return nil
+}
+
+func testEphemeralResourceSchema() ephemeralschema.Schema {
+	return ephemeralschema.Schema{
+		Attributes: map[string]ephemeralschema.Attribute{
+			names.AttrARN: ephemeralschema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: ephemeralschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: tftags.MapType.ElemType,
+				Optional:    true,
+				Computed:    true,
+			},
+			names.AttrTagsAll: ephemeralschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: tftags.MapType.ElemType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func testTagsValue(tags map[string]string) tftypes.Value {
+	if tags == nil {
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+	}
+
+	elems := make(map[string]tftypes.Value, len(tags))
+	for k, v := range tags {
+		elems[k] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems)
+}
+
+func testEphemeralResourceValue(t *testing.T, s ephemeralschema.Schema, arn string, tags, tagsAll map[string]string) tftypes.Value {
+	t.Helper()
+
+	arnValue := tftypes.NewValue(tftypes.String, nil)
+	if arn != "" {
+		arnValue = tftypes.NewValue(tftypes.String, arn)
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		names.AttrARN:     arnValue,
+		names.AttrTags:    testTagsValue(tags),
+		names.AttrTagsAll: testTagsValue(tagsAll),
+	})
+}
+
+func testTagsAttribute(t *testing.T, data tfsdk.EphemeralResultData, name string) map[string]string {
+	t.Helper()
+
+	ctx := context.Background()
+	var tags tftags.Map
+	if diags := data.GetAttribute(ctx, path.Root(name), &tags); diags.HasError() {
+		t.Fatalf("getting %s: %v", name, diags)
+	}
+
+	if tags.IsNull() {
+		return nil
+	}
+
+	v := make(map[string]string)
+	for k, e := range tags.Elements() {
+		v[k] = e.(basetypes.StringValue).ValueString()
+	}
+
+	return v
+}
+
+func TestTagsEphemeralResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const arn = "arn:aws:test:us-west-2:123456789012:thing/test" //lintignore:AWSAT003,AWSAT005
+
+	apiTags := map[string]string{
+		"key1":                   "value1",
+		"ignored":                "value2",
+		"aws:cloudformation:foo": "system",
+	}
+
+	testCases := map[string]struct {
+		tags            *types.ServicePackageResourceTags
+		configTags      map[string]string
+		tagsOut         map[string]string // Tags set by the Open handler.
+		nullResult      bool
+		wantTagsIn      map[string]string
+		wantIdentifiers []string
+		wantTags        map[string]string
+		wantTagsAll     map[string]string
+	}{
+		"no transparent tagging": {
+			configTags: map[string]string{"key1": "value1"},
+		},
+		"list tags": {
+			tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			configTags:      map[string]string{"key1": "value1"},
+			wantTagsIn:      map[string]string{"key1": "value1"},
+			wantIdentifiers: []string{arn},
+			wantTags:        map[string]string{"key1": "value1"},
+		},
+		"list tags with tags_all": {
+			tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+				TagsAll:             true,
+			},
+			wantTagsIn:      map[string]string{},
+			wantIdentifiers: []string{arn},
+			wantTags:        map[string]string{"key1": "value1"},
+			wantTagsAll:     map[string]string{"key1": "value1", "default": "value3"},
+		},
+		"tags set by handler": {
+			tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			tagsOut:    map[string]string{"key2": "value2", "ignored": "value2"},
+			wantTagsIn: map[string]string{},
+			wantTags:   map[string]string{"key2": "value2"},
+		},
+		"null result": {
+			tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			nullResult: true,
+			wantTagsIn: map[string]string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sp := &testTagsServicePackage{tags: apiTags}
+			meta := &conns.AWSClient{}
+			meta.SetServicePackages(ctx, map[string]conns.ServicePackage{
+				sp.ServicePackageName(): sp,
+			})
+
+			ctx = conns.NewResourceContext(ctx, sp.ServicePackageName(), "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{"default": "value3"}),
+			}, &tftags.IgnoreConfig{
+				Keys: tftags.New(ctx, []string{"ignored"}),
+			})
+
+			s := testEphemeralResourceSchema()
+			interceptor := tagsEphemeralResourceInterceptor{tags: testCase.tags}
+			request := ephemeral.OpenRequest{
+				Config: tfsdk.Config{Schema: s, Raw: testEphemeralResourceValue(t, s, "", testCase.configTags, nil)},
+			}
+			response := ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+
+			ctx, diags := interceptor.open(ctx, request, &response, meta, Before, nil)
+			if diags.HasError() {
+				t.Fatalf("Before: unexpected error: %v", diags)
+			}
+
+			inContext, _ := tftags.FromContext(ctx)
+			if testCase.wantTagsIn == nil {
+				if inContext.TagsIn.IsSome() {
+					t.Errorf("Before: unexpected TagsIn: %v", inContext.TagsIn.MustUnwrap().Map())
+				}
+			} else if got := inContext.TagsIn.UnwrapOrDefault().Map(); !maps.Equal(got, testCase.wantTagsIn) {
+				t.Errorf("Before: TagsIn = %v, want %v", got, testCase.wantTagsIn)
+			}
+
+			// Simulate the Open handler.
+			if !testCase.nullResult {
+				response.Result.Raw = testEphemeralResourceValue(t, s, arn, nil, nil)
+			}
+			if testCase.tagsOut != nil {
+				inContext.TagsOut = option.Some(tftags.New(ctx, testCase.tagsOut))
+			}
+
+			_, diags = interceptor.open(ctx, request, &response, meta, After, nil)
+			if diags.HasError() {
+				t.Fatalf("After: unexpected error: %v", diags)
+			}
+
+			if got, want := sp.identifiers, testCase.wantIdentifiers; !slices.Equal(got, want) {
+				t.Errorf("After: ListTags identifiers = %v, want %v", got, want)
+			}
+
+			if testCase.nullResult {
+				if !response.Result.Raw.IsNull() {
+					t.Errorf("After: result = %s, want null", response.Result.Raw)
+				}
+				return
+			}
+
+			if got, want := testTagsAttribute(t, response.Result, names.AttrTags), testCase.wantTags; !maps.Equal(got, want) {
+				t.Errorf("After: %s = %v, want %v", names.AttrTags, got, want)
+			}
+			if got, want := testTagsAttribute(t, response.Result, names.AttrTagsAll), testCase.wantTagsAll; !maps.Equal(got, want) {
+				t.Errorf("After: %s = %v, want %v", names.AttrTagsAll, got, want)
+			}
+		})
+	}
+}
@@ -12,24 +12,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -253,6 +258,21 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"concurrency_limit": schema.ListNestedBlock{
+				Description: "Overrides the maximum number of concurrent operations for a named concurrency limit.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of concurrent operations. `0` removes the limit.",
+						},
+						names.AttrName: schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the concurrency limit, e.g. `ec2.network_interface_attach`.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -267,6 +287,35 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Rules that scope default tags to resources by service or resource type. Matching rules are evaluated in order.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Glob patterns for default tag keys that are not applied to matching resources.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Glob patterns for the resource types that the rule matches, e.g. `aws_db_*`.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service package names, or `endpoints` aliases, that the rule matches.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Additional resource tags to default across matching resources.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -292,6 +341,135 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Client-side limits on the rate of API calls made for a service or one of its operations.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of API calls made without waiting. Defaults to one second's worth of calls.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The AWS API operation name, e.g. `ChangeResourceRecordSets`. If not set, the limit applies to all of the service's operations.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The maximum rate of API calls. `0` removes any default limit.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service whose API calls are limited. Use the same names as in the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"service_assume_role": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"duration": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"external_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "A unique identifier that might be required when you assume a role in another account.",
+						},
+						"policy": schema.StringAttribute{
+							Optional:    true,
+							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+						},
+						"policy_arns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+						},
+						"role_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls for the specified services.",
+						},
+						"services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Services whose API calls are made using the assumed IAM Role. Use the same names as in the `endpoints` block.",
+						},
+						"session_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "An identifier for the assumed role session.",
+						},
+						"source_identity": schema.StringAttribute{
+							Optional:    true,
+							Description: "Source identity specified by the principal assuming the role.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Assume role session tags.",
+						},
+						"transitive_tag_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Assume role session tag keys to pass to any subsequent sessions.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to enforce required resource tags across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"organizations_policy": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							Description: "Enforce the account's effective AWS Organizations tag policy, read when the provider is configured.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"severity": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[tftags.PolicySeverity](),
+										},
+										Description: "Whether violations of the policy are reported as errors or warnings. Valid values are `error` and `warning`.",
+									},
+								},
+							},
+						},
+						"rule": schema.ListNestedBlock{
+							Description: "Rules that each require a resource tag and, optionally, constrain its value.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values that the resource tag may have.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Key of the required resource tag.",
+									},
+									"pattern": schema.StringAttribute{
+										CustomType:  fwtypes.RegexpType,
+										Optional:    true,
+										Description: "Regular expression that the resource tag's value must match.",
+									},
+									"severity": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[tftags.PolicySeverity](),
+										},
+										Description: "Whether violations of the rule are reported as errors or warnings. Valid values are `error` and `warning`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -342,7 +520,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -352,13 +530,20 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				return ctx
 			}
 			interceptors := dataSourceInterceptors{}
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			// Regional data sources support the per-resource `region` argument.
+			// Global services' data sources reject it as an unsupported argument.
+			var isRegional bool
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok && !names.IsGlobal(servicePackageName) {
+				isRegional = true
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -369,11 +554,23 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 					continue
 				}
 
+				if v.Tags.TagsAll {
+					if v, ok := schemaResponse.Schema.Attributes[names.AttrTagsAll]; ok {
+						if !v.IsComputed() {
+							errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTagsAll, typeName))
+							continue
+						}
+					} else {
+						errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
+						continue
+					}
+				}
+
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, isRegional)
 			})
 		}
 	}
@@ -418,7 +615,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -428,13 +625,20 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// Regional resources support the per-resource `region` argument.
+			// Global services' resources reject it as an unsupported argument.
+			var isRegional bool
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok && !names.IsGlobal(servicePackageName) {
+				isRegional = true
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -457,8 +661,17 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				// The resource has declared an identity.
+				// Ensure that each identity attribute is a string attribute defined in the schema.
+				if err := validateIdentity(ctx, v.Identity, schemaResponse.Schema, isRegional); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, isRegional, v.Identity)
 			})
 		}
 	}
@@ -508,8 +721,9 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 					if meta != nil {
+						ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 						ctx = meta.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
 						ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
@@ -517,8 +731,44 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					return ctx
 				}
 
+				// Regional ephemeral resources support the per-resource `region` argument.
+				// Global services' ephemeral resources reject it as an unsupported argument.
+				schemaResponse := ephemeral.SchemaResponse{}
+				inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+				_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+				isRegional := !ok && !names.IsGlobal(servicePackageName)
+
+				interceptors := ephemeralResourceInterceptors{}
+
+				if v.Tags != nil {
+					// The ephemeral resource has opted in to transparent tagging.
+					// Ensure that the schema look OK.
+					if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
+						if !v.IsComputed() {
+							errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
+							continue
+						}
+					} else {
+						errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName))
+						continue
+					}
+					if v.Tags.TagsAll {
+						if v, ok := schemaResponse.Schema.Attributes[names.AttrTagsAll]; ok {
+							if !v.IsComputed() {
+								errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTagsAll, typeName))
+								continue
+							}
+						} else {
+							errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
+							continue
+						}
+					}
+
+					interceptors = append(interceptors, tagsEphemeralResourceInterceptor{tags: v.Tags})
+				}
+
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
-					return newWrappedEphemeralResource(bootstrapContext, inner, nil)
+					return newWrappedEphemeralResource(bootstrapContext, inner, interceptors, isRegional)
 				})
 			}
 		}
@@ -533,6 +783,92 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// A List Resource enumerates the existing remote objects of the Plugin SDK resource type with the same name,
+// for discovery of unmanaged infrastructure and generation of import blocks.
+// The resource must have declared an identity.
+// All List Resources must have unique names.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	// The list resources' schemas are those of the registered Plugin SDK resources.
+	var resourcesMap map[string]*sdkschema.Resource
+	if v, ok := p.Primary.(*sdkschema.Provider); ok {
+		resourcesMap = v.ResourcesMap
+	}
+
+	typeNames := make(map[string]struct{})
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		data, ok := sp.(conns.ServicePackageWithListResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+		sdkResources := make(map[string]*itypes.ServicePackageSDKResource)
+		for _, v := range sp.SDKResources(ctx) {
+			sdkResources[v.TypeName] = v
+		}
+
+		for _, v := range data.ListResources(ctx) {
+			typeName := v.TypeName
+
+			if _, ok := typeNames[typeName]; ok {
+				errs = append(errs, fmt.Errorf("duplicate list resource: %s", typeName))
+				continue
+			}
+			typeNames[typeName] = struct{}{}
+
+			r, ok := resourcesMap[typeName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("no Plugin SDK resource for list resource: %s", typeName))
+				continue
+			}
+			if r.Identity == nil {
+				errs = append(errs, fmt.Errorf("no identity declared for list resource: %s", typeName))
+				continue
+			}
+
+			// Regional list resources support the per-list `region` argument.
+			// Global services' list resources reject it as an unsupported argument.
+			// The registered resource's schema includes any provider-managed `region` attribute, so check the resource as implemented.
+			var isRegional bool
+			if v, ok := sdkResources[typeName]; ok {
+				_, ok := v.Factory().SchemaMap()[names.AttrRegion]
+				isRegional = !ok && !names.IsGlobal(servicePackageName)
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
+				if meta != nil {
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
+					ctx = meta.RegisterLogger(ctx)
+				}
+				return ctx
+			}
+
+			listResources = append(listResources, func() list.ListResource {
+				inner := v.Factory()
+				inner.SetResource(r)
+
+				return newWrappedListResource(bootstrapContext, inner, isRegional)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -542,6 +878,17 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRAllocateFunction,
+		tffunction.NewCIDRIsRFC1918Function,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewECRImageURIBuildFunction,
+		tffunction.NewECRImageURIParseFunction,
+		tffunction.NewIAMPolicyEqualFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewScheduleExpressionNextFunction,
+		tffunction.NewScheduleExpressionValidateFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+	// regionPrivateStateKey is the ephemeral resource private state key used to pass any Region override to Renew and Close.
+	regionPrivateStateKey = "region"
+)
+
+// regionResourceAttribute returns the schema for the provider-managed per-resource `region` argument.
+func regionResourceAttribute() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: regionDescription,
+	}
+}
+
+// regionDataSourceAttribute returns the schema for the provider-managed per-data source `region` argument.
+func regionDataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: regionDescription,
+	}
+}
+
+// regionEphemeralResourceAttribute returns the schema for the provider-managed per-ephemeral resource `region` argument.
+func regionEphemeralResourceAttribute() ephemeralschema.Attribute {
+	return ephemeralschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: regionDescription,
+	}
+}
+
+// regionListResourceAttribute returns the schema for the provider-managed per-list resource `region` argument.
+func regionListResourceAttribute() listschema.Attribute {
+	return listschema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "Region in which to list remote objects. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// setOverrideRegion sets any per-resource Region override in Context.
+func setOverrideRegion(ctx context.Context, region string) {
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+}
+
+// privateState is implemented by ephemeral resource private state data.
+type privateState interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+// setRegionPrivateState stores any Region override in ephemeral resource private state.
+// Private state values must be valid JSON.
+func setRegionPrivateState(ctx context.Context, private privateState, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := json.Marshal(region)
+	if err != nil {
+		diags.AddError("encoding private state", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, regionPrivateStateKey, v)
+}
+
+// regionPrivateState returns any Region override stored in ephemeral resource private state.
+func regionPrivateState(ctx context.Context, private privateState) (string, diag.Diagnostics) {
+	var region string
+
+	v, diags := private.GetKey(ctx, regionPrivateStateKey)
+	if diags.HasError() || len(v) == 0 {
+		return region, diags
+	}
+
+	if err := json.Unmarshal(v, &region); err != nil {
+		diags.AddError("decoding private state", err.Error())
+	}
+
+	return region, diags
+}
+
+// The provider-managed `region` attribute is not part of any inner resource's schema or model.
+// These functions strip the attribute from raw values passed to inner resources and restore it afterwards.
+
+// withoutRegion returns a copy of the specified object value with the `region` attribute removed.
+func withoutRegion(v tftypes.Value) tftypes.Value {
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return v
+	}
+	if _, ok := typ.AttributeTypes[names.AttrRegion]; !ok {
+		return v
+	}
+
+	attrTypes := maps.Clone(typ.AttributeTypes)
+	delete(attrTypes, names.AttrRegion)
+	optionalAttrs := maps.Clone(typ.OptionalAttributes)
+	delete(optionalAttrs, names.AttrRegion)
+	typ = tftypes.Object{AttributeTypes: attrTypes, OptionalAttributes: optionalAttrs}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		// Can't happen for known, non-null object values.
+		return v
+	}
+	// As returns the value's underlying map.
+	attrs = maps.Clone(attrs)
+	delete(attrs, names.AttrRegion)
+
+	return tftypes.NewValue(typ, attrs)
+}
+
+// withRegion returns a copy of the specified object value with the `region` attribute set to the specified value.
+func withRegion(v, region tftypes.Value) tftypes.Value {
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return v
+	}
+
+	attrTypes := maps.Clone(typ.AttributeTypes)
+	if attrTypes == nil {
+		attrTypes = make(map[string]tftypes.Type)
+	}
+	attrTypes[names.AttrRegion] = tftypes.String
+	typ = tftypes.Object{AttributeTypes: attrTypes, OptionalAttributes: typ.OptionalAttributes}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		// Can't happen for known, non-null object values.
+		return v
+	}
+	// As returns the value's underlying map.
+	attrs = maps.Clone(attrs)
+	attrs[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attrs)
+}
+
+// regionValue returns the `region` attribute value from the specified object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if !v.IsKnown() {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+	if region, ok := attrs[names.AttrRegion]; ok {
+		return region
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionString returns the known, non-null `region` attribute value from the specified object value, or "".
+func regionString(v tftypes.Value) string {
+	var region string
+	if v := regionValue(v); v.IsKnown() && !v.IsNull() {
+		if err := v.As(&region); err != nil {
+			return ""
+		}
+	}
+
+	return region
+}
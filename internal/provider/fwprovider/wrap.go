@@ -7,10 +7,22 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // contextFunc augments Context.
@@ -22,17 +34,28 @@ type wrappedDataSource struct {
 	bootstrapContext contextFunc
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
-	meta             *conns.AWSClient
+	// isRegional is set if the data source supports the per-resource `region` argument.
+	isRegional bool
+	meta       *conns.AWSClient
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, isRegional bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		isRegional:       isRegional,
 	}
 }
 
+// innerSchema returns the inner data source's schema.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) datasourceschema.Schema {
+	response := datasource.SchemaResponse{}
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Metadata(ctx, request, response)
@@ -41,16 +64,32 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegional {
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.isRegional {
+			innerSchema := w.innerSchema(ctx)
+			request.Config = tfsdk.Config{Schema: innerSchema, Raw: withoutRegion(request.Config.Raw)}
+			schema, region := response.State.Schema, regionValue(response.State.Raw)
+			response.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(response.State.Raw)}
+			defer func() {
+				response.State = tfsdk.State{Schema: schema, Raw: withRegion(response.State.Raw, region)}
+			}()
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startHandlerSpan(ctx, "Read")
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	endHandlerSpan(span, diags)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -77,16 +116,27 @@ type wrappedEphemeralResource struct {
 	inner            ephemeral.EphemeralResourceWithConfigure
 	meta             *conns.AWSClient
 	interceptors     ephemeralResourceInterceptors
+	// isRegional is set if the ephemeral resource supports the per-resource `region` argument.
+	isRegional bool
 }
 
-func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure, interceptors ephemeralResourceInterceptors) ephemeral.EphemeralResourceWithConfigure {
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure, interceptors ephemeralResourceInterceptors, isRegional bool) ephemeral.EphemeralResourceWithConfigure {
 	return &wrappedEphemeralResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		isRegional:       isRegional,
 	}
 }
 
+// innerSchema returns the inner ephemeral resource's schema.
+func (w *wrappedEphemeralResource) innerSchema(ctx context.Context) ephemeralschema.Schema {
+	response := ephemeral.SchemaResponse{}
+	w.inner.Schema(ctx, ephemeral.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
 func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Metadata(ctx, request, response)
@@ -95,11 +145,48 @@ func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemer
 func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegional {
+		response.Schema.Attributes[names.AttrRegion] = regionEphemeralResourceAttribute()
+	}
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	f := func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+		if !w.isRegional {
+			w.inner.Open(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		region := regionString(request.Config.Raw)
+		if region != "" {
+			setOverrideRegion(ctx, region)
+		}
+
+		innerSchema := w.innerSchema(ctx)
+		request.Config = tfsdk.Config{Schema: innerSchema, Raw: withoutRegion(request.Config.Raw)}
+		schema := response.Result.Schema
+		response.Result = tfsdk.EphemeralResultData{Schema: innerSchema, Raw: withoutRegion(response.Result.Raw)}
+
+		w.inner.Open(ctx, request, response)
+
+		response.Result = tfsdk.EphemeralResultData{Schema: schema, Raw: withRegion(response.Result.Raw, tftypes.NewValue(tftypes.String, nil))}
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		if w.meta != nil {
+			response.Diagnostics.Append(response.Result.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.Region(ctx))...)
+		}
+		if region != "" && response.Private != nil {
+			response.Diagnostics.Append(setRegionPrivateState(ctx, response.Private, region)...)
+		}
+
+		return response.Diagnostics
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Open(ctx, request, response)
+	diags := interceptedEphemeralResourceOpenHandler(w.interceptors.open(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
@@ -113,6 +200,13 @@ func (w *wrappedEphemeralResource) Configure(ctx context.Context, request epheme
 func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.isRegional && request.Private != nil {
+			region, diags := regionPrivateState(ctx, request.Private)
+			response.Diagnostics.Append(diags...)
+			if region != "" {
+				setOverrideRegion(ctx, region)
+			}
+		}
 		v.Renew(ctx, request, response)
 	}
 }
@@ -120,6 +214,13 @@ func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.
 func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.isRegional && request.Private != nil {
+			region, diags := regionPrivateState(ctx, request.Private)
+			response.Diagnostics.Append(diags...)
+			if region != "" {
+				setOverrideRegion(ctx, region)
+			}
+		}
 		v.Close(ctx, request, response)
 	}
 }
@@ -136,6 +237,9 @@ func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephem
 func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.isRegional {
+			request.Config = tfsdk.Config{Schema: w.innerSchema(ctx), Raw: withoutRegion(request.Config.Raw)}
+		}
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -144,17 +248,39 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	// identity is set if the resource has declared an identity.
+	identity     *itypes.ServicePackageResourceIdentity
+	inner        resource.ResourceWithConfigure
+	interceptors resourceInterceptors
+	// isRegional is set if the resource supports the per-resource `region` argument.
+	isRegional bool
+	meta       *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, isRegional bool, identity *itypes.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	w := &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		identity:         identity,
 		inner:            inner,
 		interceptors:     interceptors,
+		isRegional:       isRegional,
 	}
+
+	if identity != nil {
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+		}
+	}
+
+	return w
+}
+
+// innerSchema returns the inner resource's schema.
+func (w *wrappedResource) innerSchema(ctx context.Context) resourceschema.Schema {
+	response := resource.SchemaResponse{}
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return response.Schema
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -165,46 +291,107 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegional {
+		response.Schema.Attributes[names.AttrRegion] = regionResourceAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.isRegional {
+			innerSchema := w.innerSchema(ctx)
+			schema, region := response.State.Schema, regionValue(request.Plan.Raw)
+			request.Config = tfsdk.Config{Schema: innerSchema, Raw: withoutRegion(request.Config.Raw)}
+			request.Plan = tfsdk.Plan{Schema: innerSchema, Raw: withoutRegion(request.Plan.Raw)}
+			response.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(response.State.Raw)}
+			defer func() {
+				response.State = tfsdk.State{Schema: schema, Raw: withRegion(response.State.Raw, region)}
+			}()
+		}
+
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startHandlerSpan(ctx, "Create")
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+	if w.identity != nil && !diags.HasError() {
+		diags.Append(setIdentity(ctx, w.identity, response.State, response.Identity)...)
+	}
 	response.Diagnostics = diags
+	endHandlerSpan(span, diags)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.isRegional {
+			innerSchema := w.innerSchema(ctx)
+			request.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(request.State.Raw)}
+			schema, region := response.State.Schema, regionValue(response.State.Raw)
+			response.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(response.State.Raw)}
+			defer func() {
+				response.State = tfsdk.State{Schema: schema, Raw: withRegion(response.State.Raw, region)}
+			}()
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startHandlerSpan(ctx, "Read")
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	if w.identity != nil && !diags.HasError() {
+		diags.Append(setIdentity(ctx, w.identity, response.State, response.Identity)...)
+	}
 	response.Diagnostics = diags
+	endHandlerSpan(span, diags)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.isRegional {
+			innerSchema := w.innerSchema(ctx)
+			schema, region := response.State.Schema, regionValue(request.Plan.Raw)
+			request.Config = tfsdk.Config{Schema: innerSchema, Raw: withoutRegion(request.Config.Raw)}
+			request.Plan = tfsdk.Plan{Schema: innerSchema, Raw: withoutRegion(request.Plan.Raw)}
+			request.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(request.State.Raw)}
+			response.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(response.State.Raw)}
+			defer func() {
+				response.State = tfsdk.State{Schema: schema, Raw: withRegion(response.State.Raw, region)}
+			}()
+		}
+
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startHandlerSpan(ctx, "Update")
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	endHandlerSpan(span, diags)
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.isRegional {
+			innerSchema := w.innerSchema(ctx)
+			request.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(request.State.Raw)}
+			schema, region := response.State.Schema, regionValue(response.State.Raw)
+			response.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(response.State.Raw)}
+			defer func() {
+				response.State = tfsdk.State{Schema: schema, Raw: withRegion(response.State.Raw, region)}
+			}()
+		}
+
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startHandlerSpan(ctx, "Delete")
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	endHandlerSpan(span, diags)
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -218,8 +405,50 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		// Import by identity sets no import ID.
+		// The first identity attribute's value is used as the import ID.
+		var identityValues map[string]string
+		if w.identity != nil && request.ID == "" && request.Identity != nil {
+			var diags diag.Diagnostics
+			identityValues, diags = importIdentityValues(ctx, w.identity, *request.Identity)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			request.ID = identityValues[w.identity.Attributes[0].Name]
+		}
+
+		// Import IDs of the form `<id>@<region>` set the resource's `region` argument.
+		var region string
+		if w.isRegional {
+			if id, v, ok := itypes.SplitRegionSuffix(request.ID); ok {
+				request.ID, region = id, v
+			} else if v, ok := identityValues[names.AttrRegion]; ok {
+				region = v
+			}
+			if region != "" {
+				setOverrideRegion(ctx, region)
+			}
+		}
+
 		v.ImportState(ctx, request, response)
 
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for name, v := range identityValues {
+			if name == names.AttrRegion && w.isRegional {
+				continue
+			}
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(name), v)...)
+		}
+		if region != "" {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		}
+
 		return
 	}
 
@@ -229,13 +458,87 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that has declared an identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identitySchema(w.identity)
+}
+
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.isRegional {
+		w.modifyPlanRegion(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.isRegional {
+			innerSchema := w.innerSchema(ctx)
+			request.Config = tfsdk.Config{Schema: innerSchema, Raw: withoutRegion(request.Config.Raw)}
+			request.Plan = tfsdk.Plan{Schema: innerSchema, Raw: withoutRegion(request.Plan.Raw)}
+			request.State = tfsdk.State{Schema: innerSchema, Raw: withoutRegion(request.State.Raw)}
+			schema, region := response.Plan.Schema, regionValue(response.Plan.Raw)
+			response.Plan = tfsdk.Plan{Schema: innerSchema, Raw: withoutRegion(response.Plan.Raw)}
+			defer func() {
+				response.Plan = tfsdk.Plan{Schema: schema, Raw: withRegion(response.Plan.Raw, region)}
+			}()
+		}
+
 		v.ModifyPlan(ctx, request, response)
 	}
 }
 
+// modifyPlanRegion defaults an unconfigured `region` argument to the provider's configured Region
+// and requires replacement if the effective Region changes.
+func (w *wrappedResource) modifyPlanRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy or provider not yet configured.
+	if request.Plan.Raw.IsNull() || w.meta == nil {
+		return
+	}
+
+	var configRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if configRegion.IsNull() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.DefaultRegion(ctx))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var planRegion types.String
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		var stateRegion types.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Resources created before the `region` argument existed have no value in state.
+		if stateRegion.ValueString() != "" && !planRegion.IsUnknown() && !planRegion.Equal(stateRegion) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	if v := planRegion.ValueString(); v != "" {
+		setOverrideRegion(ctx, v)
+	}
+}
+
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	if v, ok := w.inner.(resource.ResourceWithConfigValidators); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
@@ -248,6 +551,9 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.isRegional {
+			request.Config = tfsdk.Config{Schema: w.innerSchema(ctx), Raw: withoutRegion(request.Config.Raw)}
+		}
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -255,8 +561,24 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-
-		return v.UpgradeState(ctx)
+		upgraders := v.UpgradeState(ctx)
+
+		if w.isRegional {
+			for k, upgrader := range upgraders {
+				if f := upgrader.StateUpgrader; f != nil {
+					upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+						// The `region` value is set on the next Read.
+						schema := response.State.Schema
+						response.State = tfsdk.State{Schema: w.innerSchema(ctx), Raw: withoutRegion(response.State.Raw)}
+						f(ctx, request, response)
+						response.State = tfsdk.State{Schema: schema, Raw: withRegion(response.State.Raw, tftypes.NewValue(tftypes.String, nil))}
+					}
+					upgraders[k] = upgrader
+				}
+			}
+		}
+
+		return upgraders
 	}
 
 	return nil
@@ -265,9 +587,132 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-
-		return v.MoveState(ctx)
+		movers := v.MoveState(ctx)
+
+		if w.isRegional {
+			for i, mover := range movers {
+				if f := mover.StateMover; f != nil {
+					mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+						// The `region` value is set on the next Read.
+						schema := response.TargetState.Schema
+						response.TargetState = tfsdk.State{Schema: w.innerSchema(ctx), Raw: withoutRegion(response.TargetState.Raw)}
+						f(ctx, request, response)
+						response.TargetState = tfsdk.State{Schema: schema, Raw: withRegion(response.TargetState.Raw, tftypes.NewValue(tftypes.String, nil))}
+					}
+					movers[i] = mover
+				}
+			}
+		}
+
+		return movers
 	}
 
 	return nil
 }
+
+// startHandlerSpan starts a trace span for a CRUD handler.
+func startHandlerSpan(ctx context.Context, operation string) (context.Context, *tracing.Span) {
+	var servicePackageName, typeName string
+	if v, ok := conns.FromContext(ctx); ok {
+		servicePackageName, typeName = v.ServicePackageName, v.TypeName
+	}
+
+	return tracing.StartHandlerSpan(ctx, operation, servicePackageName, typeName)
+}
+
+// endHandlerSpan ends a CRUD handler's trace span, recording any error.
+func endHandlerSpan(span *tracing.Span, diags diag.Diagnostics) {
+	if errs := diags.Errors(); len(errs) > 0 {
+		span.SetError(errs[0].Summary())
+	}
+
+	span.End()
+}
+
+// wrappedListResource represents an interceptor dispatcher for a list resource.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            itypes.ListResourceForSDK
+	meta             *conns.AWSClient
+	// isRegional is set if the list resource supports the per-list `region` argument.
+	isRegional bool
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner itypes.ListResourceForSDK, isRegional bool) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		isRegional:       isRegional,
+	}
+}
+
+// innerSchema returns the inner list resource's schema.
+func (w *wrappedListResource) innerSchema(ctx context.Context) listschema.Schema {
+	response := list.ListResourceSchemaResponse{}
+	w.inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+
+	if w.isRegional {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]listschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionListResourceAttribute()
+	}
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.RawV5Schemas(ctx, request, response)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	if w.isRegional {
+		if region := regionString(request.Config.Raw); region != "" {
+			setOverrideRegion(ctx, region)
+		}
+		request.Config = tfsdk.Config{Schema: w.innerSchema(ctx), Raw: withoutRegion(request.Config.Raw)}
+	}
+
+	ctx, span := startHandlerSpan(ctx, "List")
+	w.inner.List(ctx, request, stream)
+
+	if f := stream.Results; f != nil {
+		stream.Results = func(yield func(list.ListResult) bool) {
+			var diags diag.Diagnostics
+			defer func() {
+				endHandlerSpan(span, diags)
+			}()
+
+			for result := range f {
+				diags.Append(result.Diagnostics...)
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	} else {
+		endHandlerSpan(span, nil)
+	}
+}
@@ -0,0 +1,461 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testResource is a minimal Plugin Framework resource.
+// If updatable is false all arguments require replacement and Update must never be called.
+type testResource struct {
+	updatable bool
+
+	// Raw values received by the resource's handlers.
+	createPlan tftypes.Value
+	updatePlan tftypes.Value
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	nameAttr := resourceschema.StringAttribute{
+		Optional: true,
+	}
+	if !r.updatable {
+		nameAttr.PlanModifiers = []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		}
+	}
+
+	response.Schema = resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			names.AttrID: resourceschema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: nameAttr,
+		},
+	}
+}
+
+func (r *testResource) Create(_ context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	r.createPlan = request.Plan.Raw
+	response.State.Raw = request.Plan.Raw
+}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(_ context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if !r.updatable {
+		response.Diagnostics.AddError("unexpected Update", "resource does not support in-place update")
+		return
+	}
+
+	r.updatePlan = request.Plan.Raw
+	response.State.Raw = request.Plan.Raw
+}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *testResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func newTestWrappedResource(inner *testResource) *wrappedResource {
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+	w := newWrappedResource(bootstrapContext, inner, resourceInterceptors{regionResourceInterceptor{}}, true, nil).(*wrappedResource)
+	w.meta = &conns.AWSClient{}
+
+	return w
+}
+
+func testResourceValue(t *testing.T, s resourceschema.Schema, name, region string) tftypes.Value {
+	t.Helper()
+
+	ctx := context.Background()
+	attrs := map[string]tftypes.Value{
+		names.AttrID:   tftypes.NewValue(tftypes.String, "id"),
+		names.AttrName: tftypes.NewValue(tftypes.String, name),
+	}
+	if _, ok := s.Attributes[names.AttrRegion]; ok {
+		if region == "" {
+			attrs[names.AttrRegion] = tftypes.NewValue(tftypes.String, nil)
+		} else {
+			attrs[names.AttrRegion] = tftypes.NewValue(tftypes.String, region)
+		}
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(ctx), attrs)
+}
+
+func testWrappedResourceSchema(t *testing.T, w *wrappedResource) resourceschema.Schema {
+	t.Helper()
+
+	response := resource.SchemaResponse{}
+	w.Schema(context.Background(), resource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+func TestWrappedResourceRegion(t *testing.T) {
+	t.Parallel()
+
+	const (
+		region      = "eu-west-1" //lintignore:AWSAT003
+		otherRegion = "us-west-2" //lintignore:AWSAT003
+	)
+
+	testCases := map[string]struct {
+		updatable bool
+	}{
+		"with Update": {
+			updatable: true,
+		},
+		"without Update": {
+			updatable: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			inner := &testResource{updatable: testCase.updatable}
+			w := newTestWrappedResource(inner)
+
+			schema := testWrappedResourceSchema(t, w)
+			if _, ok := schema.Attributes[names.AttrRegion]; !ok {
+				t.Fatalf("no %s attribute in schema", names.AttrRegion)
+			}
+			innerSchema := w.innerSchema(ctx)
+			if _, ok := innerSchema.Attributes[names.AttrRegion]; ok {
+				t.Fatalf("unexpected %s attribute in inner schema", names.AttrRegion)
+			}
+
+			// Create.
+			plan := testResourceValue(t, schema, "test", region)
+			createResponse := resource.CreateResponse{
+				State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+			}
+			w.Create(ctx, resource.CreateRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: plan},
+				Plan:   tfsdk.Plan{Schema: schema, Raw: plan},
+			}, &createResponse)
+			if createResponse.Diagnostics.HasError() {
+				t.Fatalf("Create: unexpected error: %v", createResponse.Diagnostics)
+			}
+
+			// The inner resource must not see the `region` attribute.
+			if got, want := inner.createPlan, testResourceValue(t, innerSchema, "test", ""); !got.Equal(want) {
+				t.Errorf("Create: inner plan = %s, want %s", got, want)
+			}
+			// The `region` attribute must be restored in state.
+			if got, want := createResponse.State.Raw, plan; !got.Equal(want) {
+				t.Errorf("Create: state = %s, want %s", got, want)
+			}
+			var gotRegion string
+			createResponse.Diagnostics.Append(createResponse.State.GetAttribute(ctx, path.Root(names.AttrRegion), &gotRegion)...)
+			if got, want := gotRegion, region; got != want {
+				t.Errorf("Create: %s = %q, want %q", names.AttrRegion, got, want)
+			}
+
+			// Plan a change to the resource's Region.
+			state := plan
+			newPlan := testResourceValue(t, schema, "test", otherRegion)
+			modifyPlanResponse := resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: schema, Raw: newPlan},
+			}
+			w.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: newPlan},
+				Plan:   tfsdk.Plan{Schema: schema, Raw: newPlan},
+				State:  tfsdk.State{Schema: schema, Raw: state},
+			}, &modifyPlanResponse)
+			if modifyPlanResponse.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan: unexpected error: %v", modifyPlanResponse.Diagnostics)
+			}
+			if got, want := len(modifyPlanResponse.RequiresReplace), 1; got != want {
+				t.Fatalf("ModifyPlan: RequiresReplace length = %d, want %d", got, want)
+			}
+			if got, want := modifyPlanResponse.RequiresReplace[0], path.Root(names.AttrRegion); !got.Equal(want) {
+				t.Errorf("ModifyPlan: RequiresReplace = %s, want %s", got, want)
+			}
+
+			if !testCase.updatable {
+				return
+			}
+
+			// Plan and apply an in-place change.
+			newPlan = testResourceValue(t, schema, "updated", region)
+			modifyPlanResponse = resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: schema, Raw: newPlan},
+			}
+			w.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: newPlan},
+				Plan:   tfsdk.Plan{Schema: schema, Raw: newPlan},
+				State:  tfsdk.State{Schema: schema, Raw: state},
+			}, &modifyPlanResponse)
+			if modifyPlanResponse.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan: unexpected error: %v", modifyPlanResponse.Diagnostics)
+			}
+			if got := modifyPlanResponse.RequiresReplace; len(got) != 0 {
+				t.Errorf("ModifyPlan: unexpected RequiresReplace: %v", got)
+			}
+
+			updateResponse := resource.UpdateResponse{
+				State: tfsdk.State{Schema: schema, Raw: state},
+			}
+			w.Update(ctx, resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: newPlan},
+				Plan:   tfsdk.Plan{Schema: schema, Raw: newPlan},
+				State:  tfsdk.State{Schema: schema, Raw: state},
+			}, &updateResponse)
+			if updateResponse.Diagnostics.HasError() {
+				t.Fatalf("Update: unexpected error: %v", updateResponse.Diagnostics)
+			}
+			if got, want := inner.updatePlan, testResourceValue(t, innerSchema, "updated", ""); !got.Equal(want) {
+				t.Errorf("Update: inner plan = %s, want %s", got, want)
+			}
+			if got, want := updateResponse.State.Raw, newPlan; !got.Equal(want) {
+				t.Errorf("Update: state = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	ctx := context.Background()
+	identity := &itypes.ServicePackageResourceIdentity{
+		Attributes: []itypes.IdentityAttribute{
+			{Name: names.AttrName},
+			{Name: names.AttrRegion, Optional: true},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+	w, ok := newWrappedResource(bootstrapContext, &testResource{}, resourceInterceptors{regionResourceInterceptor{}}, true, identity).(*wrappedResourceWithIdentity)
+	if !ok {
+		t.Fatalf("wrapped resource is not a *wrappedResourceWithIdentity")
+	}
+	w.meta = &conns.AWSClient{}
+
+	if err := validateIdentity(ctx, identity, w.innerSchema(ctx), true); err != nil {
+		t.Fatalf("validateIdentity: unexpected error: %s", err)
+	}
+
+	identitySchemaResponse := resource.IdentitySchemaResponse{}
+	w.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResponse)
+	identitySchema := identitySchemaResponse.IdentitySchema
+	if got, want := len(identitySchema.Attributes), 2; got != want {
+		t.Fatalf("IdentitySchema: attributes length = %d, want %d", got, want)
+	}
+	if !identitySchema.Attributes[names.AttrName].IsRequiredForImport() {
+		t.Errorf("IdentitySchema: %s is not required for import", names.AttrName)
+	}
+	if !identitySchema.Attributes[names.AttrRegion].IsOptionalForImport() {
+		t.Errorf("IdentitySchema: %s is not optional for import", names.AttrRegion)
+	}
+	identityType := identitySchema.Type().TerraformType(ctx)
+
+	// Create sets the identity from state.
+	schema := testWrappedResourceSchema(t, w.wrappedResource)
+	plan := testResourceValue(t, schema, "test", region)
+	createResponse := resource.CreateResponse{
+		State:    tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	w.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: plan},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: plan},
+	}, &createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("Create: unexpected error: %v", createResponse.Diagnostics)
+	}
+	wantIdentity := tftypes.NewValue(identityType, map[string]tftypes.Value{
+		names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+		names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+	})
+	if got, want := createResponse.Identity.Raw, wantIdentity; !got.Equal(want) {
+		t.Errorf("Create: identity = %s, want %s", got, want)
+	}
+
+	// Import by identity uses the first identity attribute as the import ID.
+	importResponse := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: wantIdentity},
+	}
+	w.ImportState(ctx, resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: wantIdentity},
+	}, &importResponse)
+	if importResponse.Diagnostics.HasError() {
+		t.Fatalf("ImportState: unexpected error: %v", importResponse.Diagnostics)
+	}
+	for name, want := range map[string]string{
+		names.AttrID:     "test",
+		names.AttrName:   "test",
+		names.AttrRegion: region,
+	} {
+		var got string
+		importResponse.Diagnostics.Append(importResponse.State.GetAttribute(ctx, path.Root(name), &got)...)
+		if got != want {
+			t.Errorf("ImportState: %s = %q, want %q", name, got, want)
+		}
+	}
+
+	// Import by identity requires the first identity attribute.
+	importResponse = resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+	}
+	w.ImportState(ctx, resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+			names.AttrName:   tftypes.NewValue(tftypes.String, nil),
+			names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+		})},
+	}, &importResponse)
+	if !importResponse.Diagnostics.HasError() {
+		t.Errorf("ImportState: expected error")
+	}
+}
+
+func TestWithoutRegionWithRegion(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrName:   tftypes.String,
+			names.AttrRegion: tftypes.String,
+		},
+	}
+	v := tftypes.NewValue(typ, map[string]tftypes.Value{
+		names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+		names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+	})
+
+	stripped := withoutRegion(v)
+	if _, ok := stripped.Type().(tftypes.Object).AttributeTypes[names.AttrRegion]; ok {
+		t.Fatalf("withoutRegion: %s attribute not removed", names.AttrRegion)
+	}
+	if got, want := regionString(stripped), ""; got != want {
+		t.Errorf("withoutRegion: region = %q, want %q", got, want)
+	}
+
+	// The original value must not be modified.
+	if got, want := regionString(v), region; got != want {
+		t.Errorf("withoutRegion: original region = %q, want %q", got, want)
+	}
+
+	restored := withRegion(stripped, regionValue(v))
+	if !restored.Equal(v) {
+		t.Errorf("withRegion = %s, want %s", restored, v)
+	}
+	if _, ok := stripped.Type().(tftypes.Object).AttributeTypes[names.AttrRegion]; ok {
+		t.Errorf("withRegion: stripped value modified")
+	}
+	if got, want := regionString(stripped), ""; got != want {
+		t.Errorf("withRegion: stripped region = %q, want %q", got, want)
+	}
+
+	// Null and unknown values keep their state.
+	for _, v := range []tftypes.Value{tftypes.NewValue(typ, nil), tftypes.NewValue(typ, tftypes.UnknownValue)} {
+		stripped := withoutRegion(v)
+		if got, want := stripped.IsNull(), v.IsNull(); got != want {
+			t.Errorf("withoutRegion(%s): IsNull = %t, want %t", v, got, want)
+		}
+		if got, want := stripped.IsKnown(), v.IsKnown(); got != want {
+			t.Errorf("withoutRegion(%s): IsKnown = %t, want %t", v, got, want)
+		}
+	}
+}
+
+// testListResource is a minimal list resource.
+type testListResource struct {
+	// Values received by the list resource's List handler.
+	config tftypes.Value
+	region string
+}
+
+func (l *testListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (l *testListResource) ListResourceConfigSchema(context.Context, list.ListResourceSchemaRequest, *list.ListResourceSchemaResponse) {
+}
+
+func (l *testListResource) RawV5Schemas(context.Context, list.RawV5SchemaRequest, *list.RawV5SchemaResponse) {
+}
+
+func (l *testListResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (l *testListResource) SetResource(*sdkschema.Resource) {}
+
+func (l *testListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	l.config = request.Config.Raw
+	l.region = (&conns.AWSClient{}).Region(ctx)
+	stream.Results = list.NoListResults
+}
+
+func TestWrappedListResourceRegion(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	ctx := context.Background()
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+	inner := &testListResource{}
+	w := newWrappedListResource(bootstrapContext, inner, true)
+
+	schemaResponse := list.ListResourceSchemaResponse{}
+	w.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+	schema := schemaResponse.Schema
+	if _, ok := schema.Attributes[names.AttrRegion]; !ok {
+		t.Fatalf("ListResourceConfigSchema: no %s attribute", names.AttrRegion)
+	}
+
+	config := tftypes.NewValue(schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+	})
+	stream := list.ListResultsStream{}
+	w.List(ctx, list.ListRequest{Config: tfsdk.Config{Schema: schema, Raw: config}}, &stream)
+
+	if got, want := inner.region, region; got != want {
+		t.Errorf("List: Region = %q, want %q", got, want)
+	}
+	if !inner.config.Equal(tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{})) {
+		t.Errorf("List: config = %s, want no `%s`", inner.config, names.AttrRegion)
+	}
+	for range stream.Results {
+		t.Errorf("List: unexpected result")
+	}
+}
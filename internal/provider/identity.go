@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newIdentitySchema returns the identity schema for a resource with the specified schema.
+// Each identity attribute must be `id` or a string attribute defined in the resource's schema.
+func newIdentitySchema(identity *types.ServicePackageResourceIdentity, s map[string]*schema.Schema) (map[string]*schema.Schema, error) {
+	identitySchema := make(map[string]*schema.Schema, len(identity.Attributes))
+
+	for i, attr := range identity.Attributes {
+		if attr.Name != names.AttrID {
+			v, ok := s[attr.Name]
+			if !ok {
+				return nil, fmt.Errorf("identity attribute `%s` not defined in schema", attr.Name)
+			}
+			if v.Type != schema.TypeString {
+				return nil, fmt.Errorf("identity attribute `%s` must be a string", attr.Name)
+			}
+		}
+		if i == 0 && attr.Optional {
+			return nil, fmt.Errorf("first identity attribute `%s` cannot be optional", attr.Name)
+		}
+
+		identitySchema[attr.Name] = &schema.Schema{
+			Type:              schema.TypeString,
+			RequiredForImport: !attr.Optional,
+			OptionalForImport: attr.Optional,
+		}
+	}
+
+	return identitySchema, nil
+}
+
+// importIdentityStateContext handles import by identity.
+// The first identity attribute's value is set as the import ID and all identity attributes are set in state.
+func importIdentityStateContext(ctx context.Context, d *schema.ResourceData, identity *types.ServicePackageResourceIdentity) error {
+	data, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %w", err)
+	}
+
+	for i, attr := range identity.Attributes {
+		v, ok := data.GetOk(attr.Name)
+		if !ok {
+			if i == 0 {
+				return fmt.Errorf("identity attribute `%s` not set", attr.Name)
+			}
+			continue
+		}
+
+		if i == 0 {
+			d.SetId(v.(string))
+		}
+		if attr.Name == names.AttrID {
+			continue
+		}
+		if attr.Name == names.AttrRegion {
+			setOverrideRegion(ctx, v.(string))
+		}
+		if err := d.Set(attr.Name, v); err != nil {
+			return fmt.Errorf("setting %s: %w", attr.Name, err)
+		}
+	}
+
+	return nil
+}
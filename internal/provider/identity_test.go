@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		names.AttrRegion: regionSchema(),
+		"size": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		attributes []types.IdentityAttribute
+		wantErr    bool
+	}{
+		"valid": {
+			attributes: []types.IdentityAttribute{
+				{Name: names.AttrName},
+				{Name: names.AttrRegion, Optional: true},
+			},
+		},
+		"id": {
+			attributes: []types.IdentityAttribute{
+				{Name: names.AttrID},
+				{Name: names.AttrRegion, Optional: true},
+			},
+		},
+		"not defined": {
+			attributes: []types.IdentityAttribute{
+				{Name: names.AttrARN},
+			},
+			wantErr: true,
+		},
+		"not a string": {
+			attributes: []types.IdentityAttribute{
+				{Name: "size"},
+			},
+			wantErr: true,
+		},
+		"first optional": {
+			attributes: []types.IdentityAttribute{
+				{Name: names.AttrName, Optional: true},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newIdentitySchema(&types.ServicePackageResourceIdentity{Attributes: testCase.attributes}, s)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := len(got), len(testCase.attributes); got != want {
+				t.Fatalf("identity schema length = %d, want %d", got, want)
+			}
+			for _, attr := range testCase.attributes {
+				v := got[attr.Name]
+				if v == nil {
+					t.Fatalf("no %s attribute in identity schema", attr.Name)
+				}
+				if got, want := v.RequiredForImport, !attr.Optional; got != want {
+					t.Errorf("%s RequiredForImport = %t, want %t", attr.Name, got, want)
+				}
+				if got, want := v.OptionalForImport, attr.Optional; got != want {
+					t.Errorf("%s OptionalForImport = %t, want %t", attr.Name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentityInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []types.IdentityAttribute{
+			{Name: names.AttrName},
+			{Name: names.AttrRegion, Optional: true},
+		},
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	injectResourceRegion(r)
+	identitySchema, err := newIdentitySchema(identity, r.SchemaMap())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return identitySchema
+		},
+	}
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+	meta := &conns.AWSClient{}
+
+	d := r.TestResourceData()
+	d.Set(names.AttrName, "test")
+	d.Set(names.AttrRegion, region)
+	d.SetId("test")
+
+	_, diags := identityInterceptor{identity: identity}.run(ctx, d, meta, After, Create, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	data, err := d.Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := data.Get(names.AttrName).(string), "test"; got != want {
+		t.Errorf("identity %s = %q, want %q", names.AttrName, got, want)
+	}
+	if got, want := data.Get(names.AttrRegion).(string), region; got != want {
+		t.Errorf("identity %s = %q, want %q", names.AttrRegion, got, want)
+	}
+}
+
+func TestImportIdentityStateContext(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []types.IdentityAttribute{
+			{Name: names.AttrName},
+			{Name: names.AttrRegion, Optional: true},
+		},
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	injectResourceRegion(r)
+	identitySchema, err := newIdentitySchema(identity, r.SchemaMap())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return identitySchema
+		},
+	}
+
+	testCases := map[string]struct {
+		identity   map[string]string
+		wantID     string
+		wantRegion string
+		wantErr    bool
+	}{
+		"name": {
+			identity: map[string]string{
+				names.AttrName: "test",
+			},
+			wantID: "test",
+		},
+		"name and region": {
+			identity: map[string]string{
+				names.AttrName:   "test",
+				names.AttrRegion: region,
+			},
+			wantID:     "test",
+			wantRegion: region,
+		},
+		"no name": {
+			identity: map[string]string{
+				names.AttrRegion: region,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+			meta := &conns.AWSClient{}
+
+			d := r.Data(&terraform.InstanceState{Identity: testCase.identity})
+
+			err := importIdentityStateContext(ctx, d, identity)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := d.Id(), testCase.wantID; got != want {
+				t.Errorf("Id() = %q, want %q", got, want)
+			}
+			if got, want := d.Get(names.AttrName).(string), testCase.wantID; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrName, got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.wantRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+			if testCase.wantRegion != "" {
+				if got, want := meta.Region(ctx), testCase.wantRegion; got != want {
+					t.Errorf("Region() = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentityID(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []types.IdentityAttribute{
+			{Name: names.AttrID},
+		},
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	identitySchema, err := newIdentitySchema(identity, r.SchemaMap())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return identitySchema
+		},
+	}
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+	meta := &conns.AWSClient{}
+
+	d := r.TestResourceData()
+	d.Set(names.AttrName, "test")
+	d.SetId("i-123456")
+
+	_, diags := identityInterceptor{identity: identity}.run(ctx, d, meta, After, Read, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	data, err := d.Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := data.Get(names.AttrID).(string), "i-123456"; got != want {
+		t.Errorf("identity %s = %q, want %q", names.AttrID, got, want)
+	}
+
+	d = r.Data(&terraform.InstanceState{Identity: map[string]string{names.AttrID: "i-123456"}})
+
+	if err := importIdentityStateContext(ctx, d, identity); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := d.Id(), "i-123456"; got != want {
+		t.Errorf("Id() = %q, want %q", got, want)
+	}
+}
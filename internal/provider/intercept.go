@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -79,6 +80,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +109,11 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		ctx, span := startHandlerSpan(ctx, why)
+		defer func() {
+			endHandlerSpan(span, diags)
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -134,6 +155,28 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+// startHandlerSpan starts a trace span for a CRUD handler.
+func startHandlerSpan(ctx context.Context, why why) (context.Context, *tracing.Span) {
+	var servicePackageName, typeName string
+	if v, ok := conns.FromContext(ctx); ok {
+		servicePackageName, typeName = v.ServicePackageName, v.TypeName
+	}
+
+	return tracing.StartHandlerSpan(ctx, why.String(), servicePackageName, typeName)
+}
+
+// endHandlerSpan ends a CRUD handler's trace span, recording any error.
+func endHandlerSpan(span *tracing.Span, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			span.SetError(d.Summary)
+			break
+		}
+	}
+
+	span.End()
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -152,7 +195,11 @@ func (ds *wrappedDataSource) Read(f schema.ReadContextFunc) schema.ReadContextFu
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	interceptors     interceptorItems
+	// identity is set if the resource has declared an identity.
+	identity     *types.ServicePackageResourceIdentity
+	interceptors interceptorItems
+	// isRegional is set if the resource supports the per-resource `region` argument.
+	isRegional bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -174,6 +221,15 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		// Import by identity sets no import ID.
+		if r.identity != nil && d.Id() == "" {
+			if err := importIdentityStateContext(ctx, d, r.identity); err != nil {
+				return nil, err
+			}
+		}
+		if r.isRegional {
+			importRegionStateContext(ctx, d)
+		}
 
 		return f(ctx, d, meta)
 	}
@@ -182,6 +238,11 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
+		if r.isRegional {
+			if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+				setOverrideRegion(ctx, v)
+			}
+		}
 
 		return f(ctx, d, meta)
 	}
@@ -195,6 +256,78 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// regionInterceptor implements per-resource Region override for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		// Use the configured (or previously computed) Region for all API calls.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			setOverrideRegion(ctx, v)
+		}
+	case After:
+		// Set region in state after CR.
+		switch why {
+		case Create, Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// identityInterceptor sets a resource's identity from its state.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		switch why {
+		case Create, Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			v, ok := d.(interface {
+				Identity() (*schema.IdentityData, error)
+			})
+			if !ok {
+				return ctx, diags
+			}
+
+			identity, err := v.Identity()
+			if err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "getting identity: %s", err)
+			}
+
+			for _, attr := range r.identity.Attributes {
+				var v any
+				if attr.Name == names.AttrID {
+					v = d.Id()
+				} else {
+					v = d.Get(attr.Name)
+				}
+				if err := identity.Set(attr.Name, v); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", attr.Name, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
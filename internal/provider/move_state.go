@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// sdkResourceStateMovers returns the Plugin SDK resource state movers declared by all service packages.
+func sdkResourceStateMovers(ctx context.Context) []conns.SDKResourceStateMover {
+	var movers []conns.SDKResourceStateMover
+
+	for _, sp := range servicePackages(ctx) {
+		if v, ok := sp.(conns.ServicePackageWithSDKResourceStateMovers); ok {
+			movers = append(movers, v.SDKResourceStateMovers(ctx)...)
+		}
+	}
+
+	return movers
+}
+
+// stateMoverServer adds MoveResourceState support to the Plugin SDK provider server, which does not implement it.
+// State is moved unchanged between resource types with compatible schemas; attributes that are only in the target's schema are null.
+type stateMoverServer struct {
+	tfprotov5.ProviderServer
+	movers map[string][]string // Target type name to source type names.
+
+	schemaOnce sync.Once
+	schemas    map[string]*tfprotov5.Schema
+	schemaErr  error
+}
+
+func newStateMoverServer(ctx context.Context, server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	movers := make(map[string][]string)
+	for _, v := range sdkResourceStateMovers(ctx) {
+		movers[v.TargetTypeName] = append(movers[v.TargetTypeName], v.SourceTypeName)
+	}
+
+	return &stateMoverServer{
+		ProviderServer: server,
+		movers:         movers,
+	}
+}
+
+func (s *stateMoverServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	response, err := s.ProviderServer.GetProviderSchema(ctx, request)
+
+	if response != nil && len(s.movers) > 0 {
+		if response.ServerCapabilities == nil {
+			response.ServerCapabilities = &tfprotov5.ServerCapabilities{}
+		}
+		response.ServerCapabilities.MoveResourceState = true
+	}
+
+	return response, err
+}
+
+func (s *stateMoverServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	if !slices.Contains(s.movers[request.TargetTypeName], request.SourceTypeName) || !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
+		return s.ProviderServer.MoveResourceState(ctx, request)
+	}
+
+	response := &tfprotov5.MoveResourceStateResponse{}
+
+	targetState, err := s.moveState(ctx, request)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Unable to Move Resource State",
+			Detail:   fmt.Sprintf("moving %s state to %s: %s", request.SourceTypeName, request.TargetTypeName, err),
+		})
+
+		return response, nil
+	}
+
+	response.TargetState = targetState
+	response.TargetPrivate = request.SourcePrivate
+
+	return response, nil
+}
+
+func (s *stateMoverServer) moveState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.DynamicValue, error) {
+	source, target, err := s.resourceSchemas(ctx, request.SourceTypeName, request.TargetTypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only state written by the current source schema version can be moved.
+	if request.SourceSchemaVersion != source.Version {
+		return nil, fmt.Errorf("unsupported source schema version %d, expected %d; apply the configuration with the source resource type using this provider version before moving", request.SourceSchemaVersion, source.Version)
+	}
+
+	if source.Version != target.Version {
+		return nil, fmt.Errorf("source schema version %d does not match target schema version %d", source.Version, target.Version)
+	}
+
+	targetType := target.ValueType()
+	if !stateMoverCompatible(source.ValueType(), targetType) {
+		return nil, fmt.Errorf("incompatible schemas")
+	}
+
+	if request.SourceState == nil {
+		return nil, fmt.Errorf("no source state")
+	}
+
+	value, err := request.SourceState.UnmarshalWithOpts(targetType, tfprotov5.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targetState, err := tfprotov5.NewDynamicValue(targetType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &targetState, nil
+}
+
+// resourceSchemas returns the schemas of the specified resource types.
+func (s *stateMoverServer) resourceSchemas(ctx context.Context, sourceTypeName, targetTypeName string) (*tfprotov5.Schema, *tfprotov5.Schema, error) {
+	s.schemaOnce.Do(func() {
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			s.schemaErr = err
+			return
+		}
+
+		for _, v := range response.Diagnostics {
+			if v.Severity == tfprotov5.DiagnosticSeverityError {
+				s.schemaErr = fmt.Errorf("%s: %s", v.Summary, v.Detail)
+				return
+			}
+		}
+
+		s.schemas = response.ResourceSchemas
+	})
+
+	if s.schemaErr != nil {
+		return nil, nil, s.schemaErr
+	}
+
+	source, ok := s.schemas[sourceTypeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("no schema for resource type %s", sourceTypeName)
+	}
+
+	target, ok := s.schemas[targetTypeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("no schema for resource type %s", targetTypeName)
+	}
+
+	return source, target, nil
+}
+
+// stateMoverCompatible returns whether every attribute of the source object type is present, with the same type, in the target object type.
+func stateMoverCompatible(source, target tftypes.Type) bool {
+	sourceObject, ok := source.(tftypes.Object)
+	if !ok {
+		return false
+	}
+
+	targetObject, ok := target.(tftypes.Object)
+	if !ok {
+		return false
+	}
+
+	for name, sourceType := range sourceObject.AttributeTypes {
+		targetType, ok := targetObject.AttributeTypes[name]
+		if !ok || !sourceType.Equal(targetType) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateMoverCompatible(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		source tftypes.Type
+		target tftypes.Type
+		want   bool
+	}{
+		{
+			name:   "identical",
+			source: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+			target: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+			want:   true,
+		},
+		{
+			name:   "target superset",
+			source: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+			target: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String, "checksum": tftypes.String}},
+			want:   true,
+		},
+		{
+			name:   "missing attribute",
+			source: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String, "name": tftypes.String}},
+			target: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+		},
+		{
+			name:   "different type",
+			source: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String, "size": tftypes.Number}},
+			target: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String, "size": tftypes.String}},
+		},
+		{
+			name:   "not an object",
+			source: tftypes.String,
+			target: tftypes.String,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := stateMoverCompatible(testCase.source, testCase.target), testCase.want; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSDKResourceStateMovers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := newStateMoverServer(ctx, p.GRPCProvider())
+
+	for _, v := range sdkResourceStateMovers(ctx) {
+		response, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+			SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			SourceSchemaVersion:   int64(p.ResourcesMap[v.SourceTypeName].SchemaVersion),
+			SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id":"test"}`)},
+			SourceTypeName:        v.SourceTypeName,
+			TargetTypeName:        v.TargetTypeName,
+		})
+		if err != nil {
+			t.Fatalf("%s to %s: %s", v.SourceTypeName, v.TargetTypeName, err)
+		}
+
+		for _, d := range response.Diagnostics {
+			t.Errorf("%s to %s: %s: %s", v.SourceTypeName, v.TargetTypeName, d.Summary, d.Detail)
+		}
+
+		if response.TargetState == nil {
+			t.Errorf("%s to %s: no target state", v.SourceTypeName, v.TargetTypeName)
+		}
+	}
+}
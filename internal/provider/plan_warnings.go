@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// planWarningsServer adds warnings recorded during the Plugin SDK provider server's PlanResourceChange to its response.
+// CustomizeDiff functions cannot return warnings, so they record them with sdkdiag.AppendContextWarningf.
+type planWarningsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func newPlanWarningsServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &planWarningsServer{
+		ProviderServer: server,
+	}
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, warnings := sdkdiag.NewWarningsContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if response != nil {
+		for _, v := range warnings() {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  v.Summary,
+				Detail:   v.Detail,
+			})
+		}
+	}
+
+	return response, err
+}
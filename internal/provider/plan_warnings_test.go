@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// planWarningsTestServer is a provider server whose PlanResourceChange records a warning in its Context.
+type planWarningsTestServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *planWarningsTestServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	sdkdiag.AppendContextWarningf(ctx, "tag policy violation (%s): %s", request.TypeName, "warning")
+
+	return &tfprotov5.PlanResourceChangeResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "existing",
+			},
+		},
+	}, nil
+}
+
+func TestPlanWarningsServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newPlanWarningsServer(&planWarningsTestServer{})
+
+	response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{TypeName: "aws_test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "existing",
+		},
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "tag policy violation (aws_test): warning",
+		},
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, want); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	// Warnings are not carried over between requests.
+	response, err = server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{TypeName: "aws_test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(response.Diagnostics), len(want); got != want {
+		t.Errorf("length of diagnostics = %d, want %d", got, want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -54,6 +56,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"concurrency_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Overrides the maximum number of concurrent operations for a named concurrency limit.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of concurrent operations. `0` removes the limit.",
+						},
+						names.AttrName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the concurrency limit, e.g. `ec2.network_interface_attach`.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -75,6 +97,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tags to default across all resources. " +
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules that scope default tags to resources by service or resource type. Matching rules are evaluated in order.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Glob patterns for default tag keys that are not applied to matching resources.",
+									},
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Glob patterns for the resource types that the rule matches, e.g. `aws_db_*`.",
+									},
+									"services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Service package names, or `endpoints` aliases, that the rule matches.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Additional resource tags to default across matching resources.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -158,6 +213,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Client-side limits on the rate of API calls made for a service or one of its operations.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of API calls made without waiting. Defaults to one second's worth of calls.",
+						},
+						"operation": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The AWS API operation name, e.g. `ChangeResourceRecordSets`. If not set, the limit applies to all of the service's operations.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "The maximum rate of API calls. `0` removes any default limit.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service whose API calls are limited. Use the same names as in the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -191,6 +277,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_assume_role": serviceAssumeRoleSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -234,6 +321,66 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to enforce required resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizations_policy": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Enforce the account's effective AWS Organizations tag policy, read when the provider is configured.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"severity": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          tftags.PolicySeverityError,
+										ValidateDiagFunc: enum.Validate[tftags.PolicySeverity](),
+										Description:      "Whether violations of the policy are reported as errors or warnings. Valid values are `error` and `warning`.",
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules that each require a resource tag and, optionally, constrain its value.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Values that the resource tag may have.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Key of the required resource tag.",
+									},
+									"pattern": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the resource tag's value must match.",
+									},
+									"severity": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          tftags.PolicySeverityError,
+										ValidateDiagFunc: enum.Validate[tftags.PolicySeverity](),
+										Description:      "Whether violations of the rule are reported as errors or warnings. Valid values are `error` and `warning`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -293,7 +440,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -303,6 +450,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Regional data sources support the per-resource `region` argument.
+			// Global services' data sources reject it as an unsupported argument.
+			if !names.IsGlobal(servicePackageName) && injectRegionAttribute(r, regionSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -369,7 +526,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -379,6 +536,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Regional resources support the per-resource `region` argument.
+			// Global services' resources reject it as an unsupported argument.
+			isRegional := !names.IsGlobal(servicePackageName) && injectResourceRegion(r)
+
+			if isRegional {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -414,9 +583,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if v.Identity != nil {
+				// The resource has declared an identity.
+				// Ensure that each identity attribute is a string attribute defined in the schema.
+				identitySchema, err := newIdentitySchema(v.Identity, r.SchemaMap())
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				r.Identity = &schema.ResourceIdentity{
+					SchemaFunc: func() map[string]*schema.Schema {
+						return identitySchema
+					},
+				}
+
+				// After interceptors are run last to first, so identity is set from the final state.
+				interceptors = append(interceptorItems{{
+					when: After,
+					why:  Create | Read,
+					interceptor: identityInterceptor{
+						identity: v.Identity,
+					},
+				}}, interceptors...)
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
+				identity:         v.Identity,
 				interceptors:     interceptors,
+				isRegional:       isRegional,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -449,6 +645,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		}
 	}
 
+	for _, v := range sdkResourceStateMovers(ctx) {
+		for _, typeName := range []string{v.SourceTypeName, v.TargetTypeName} {
+			if _, ok := provider.ResourcesMap[typeName]; !ok {
+				errs = append(errs, fmt.Errorf("unknown resource in state mover (%s to %s): %s", v.SourceTypeName, v.TargetTypeName, typeName))
+			}
+		}
+	}
+
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
@@ -559,8 +763,48 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok {
+		rateLimits, dg := expandRateLimits(cty.GetAttrPath("rate_limit"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("service_assume_role"); ok {
+		sar, dg := expandServiceAssumeRoles(ctx, cty.GetAttrPath("service_assume_role"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceAssumeRoles = sar
+	}
+
+	if v, ok := d.GetOk("concurrency_limit"); ok {
+		concurrencyLimits, dg := expandConcurrencyLimits(cty.GetAttrPath("concurrency_limit"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ConcurrencyLimits = concurrencyLimits
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		config.DefaultTagsConfig = expandDefaultTags(ctx, tfMap)
+
+		if v, ok := tfMap["rule"].([]any); ok && len(v) > 0 {
+			rules, dg := expandDefaultTagsRules(ctx, cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("rule"), v)
+			diags = append(diags, dg...)
+			if dg.HasError() {
+				return nil, diags
+			}
+			if config.DefaultTagsConfig == nil {
+				config.DefaultTagsConfig = &tftags.DefaultConfig{}
+			}
+			config.DefaultTagsConfig.Rules = rules
+		}
 	} else {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
@@ -598,6 +842,26 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+
+		if v, ok := tfMap["rule"].([]any); ok && len(v) > 0 {
+			config.TagPolicyConfig = &tftags.PolicyConfig{
+				Rules: expandTagPolicyRules(v),
+			}
+		}
+
+		if v, ok := tfMap["organizations_policy"].([]any); ok && len(v) > 0 {
+			// An empty block enables the policy with the default severity.
+			config.TagPolicyOrganizationsSeverity = tftags.PolicySeverityError
+			if tfMap, ok := v[0].(map[string]any); ok {
+				if v, ok := tfMap["severity"].(string); ok && v != "" {
+					config.TagPolicyOrganizationsSeverity = tftags.PolicySeverity(v)
+				}
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -705,6 +969,30 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func serviceAssumeRoleSchema() *schema.Schema {
+	// The IAM role attributes are the same as those of the provider-level `assume_role` block.
+	elem := assumeRoleSchema().Elem.(*schema.Resource)
+	elem.Schema["role_arn"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls for the specified services.",
+		ValidateFunc: verify.ValidARN,
+	}
+	elem.Schema["services"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: "Services whose API calls are made using the assumed IAM Role. Use the same names as in the `endpoints` block.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     elem,
+	}
+}
+
 func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -831,6 +1119,57 @@ func expandAssumeRole(_ context.Context, path cty.Path, tfMap map[string]any) (r
 	return result, diags
 }
 
+func expandServiceAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []conns.ServiceAssumeRole, diags diag.Diagnostics) {
+	result = make([]conns.ServiceAssumeRole, 0, len(tfList))
+
+	for i, v := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			return result, append(diags, errs.NewAttributeRequiredError(path, "role_arn"))
+		}
+
+		ar, d := expandAssumeRole(ctx, path, tfMap)
+		diags = append(diags, d...)
+		if d.HasError() {
+			return result, diags
+		}
+
+		var services []string
+		for _, v := range tfMap["services"].(*schema.Set).List() {
+			service, err := validServicePackageName(v.(string))
+			if err != nil {
+				return result, append(diags, errs.NewInvalidValueAttributeError(path.GetAttr("services"), err.Error()))
+			}
+			services = append(services, service)
+		}
+
+		result = append(result, conns.ServiceAssumeRole{
+			AssumeRole: ar,
+			Services:   services,
+		})
+		tflog.Info(ctx, "service_assume_role configuration set", map[string]any{
+			"tf_aws.service_assume_role.index":        i,
+			"tf_aws.service_assume_role.role_arn":     ar.RoleARN,
+			"tf_aws.service_assume_role.session_name": ar.SessionName,
+			"tf_aws.service_assume_role.services":     services,
+		})
+	}
+
+	return result, diags
+}
+
+// validServicePackageName returns the service package name for the specified service name
+// given in provider configuration, e.g. in `service_assume_role`, `default_tags` or `rate_limit` blocks.
+// Both service package names and their `endpoints` aliases are accepted.
+func validServicePackageName(service string) (string, error) {
+	if slices.Contains(names.ProviderPackages(), service) {
+		return service, nil
+	}
+
+	return names.ProviderPackageForAlias(service)
+}
+
 func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRoleWithWebIdentity {
 	if tfMap == nil {
 		return nil
@@ -894,6 +1233,126 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+func expandDefaultTagsRules(ctx context.Context, path cty.Path, tfList []any) (result []tftags.DefaultRule, diags diag.Diagnostics) {
+	result = make([]tftags.DefaultRule, 0, len(tfList))
+
+	for i, v := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			// An empty rule block matches all resources and changes nothing.
+			continue
+		}
+
+		var rule tftags.DefaultRule
+
+		if v, ok := tfMap["exclude_keys"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ExcludeKeys = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["services"].(*schema.Set); ok {
+			for _, v := range v.List() {
+				service, err := validServicePackageName(v.(string))
+				if err != nil {
+					return result, append(diags, errs.NewInvalidValueAttributeError(path.GetAttr("services"), err.Error()))
+				}
+				rule.Services = append(rule.Services, service)
+			}
+		}
+
+		if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+			rule.Tags = tftags.New(ctx, v)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return result, append(diags, errs.NewInvalidValueAttributeError(path, err.Error()))
+		}
+
+		result = append(result, rule)
+	}
+
+	return result, diags
+}
+
+func expandConcurrencyLimits(path cty.Path, tfList []any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limits := make(map[string]int, len(tfList))
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := limits[name]; ok {
+			return nil, append(diags, errs.NewInvalidValueAttributeError(path.IndexInt(i).GetAttr(names.AttrName), fmt.Sprintf("concurrency limit (%s) is specified in more than one concurrency_limit block", name)))
+		}
+		limits[name] = tfMap["limit"].(int)
+	}
+
+	return limits, diags
+}
+
+func expandRateLimits(path cty.Path, tfList []any) ([]conns.RateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiObjects := make([]conns.RateLimit, 0, len(tfList))
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service, err := validServicePackageName(tfMap["service"].(string))
+		if err != nil {
+			return nil, append(diags, errs.NewInvalidValueAttributeError(path.IndexInt(i).GetAttr("service"), err.Error()))
+		}
+
+		apiObjects = append(apiObjects, conns.RateLimit{
+			Burst:             tfMap["burst"].(int),
+			Operation:         tfMap["operation"].(string),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			Service:           service,
+		})
+	}
+
+	return apiObjects, diags
+}
+
+func expandTagPolicyRules(tfList []any) []tftags.PolicyRule {
+	apiObjects := make([]tftags.PolicyRule, 0, len(tfList))
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := tftags.PolicyRule{
+			Key:      tfMap[names.AttrKey].(string),
+			Severity: tftags.PolicySeverity(tfMap["severity"].(string)),
+		}
+
+		if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedValues = flex.ExpandStringValueSet(v)
+		}
+
+		// Patterns are validated by the schema.
+		if v, ok := tfMap["pattern"].(string); ok && v != "" {
+			apiObject.Pattern = regexache.MustCompile(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
 
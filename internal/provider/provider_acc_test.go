@@ -655,6 +655,28 @@ func TestAccProvider_AssumeRole_empty(t *testing.T) {
 	})
 }
 
+func TestAccProvider_ServiceAssumeRole_accountID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider *schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactoriesInternal(ctx, t, &provider),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_serviceAssumeRole("arn:aws:iam::210987654321:role/dns", "route53"), //lintignore:AWSAT005
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountID(ctx, t, &provider, names.Route53, "210987654321"),
+					testAccCheckServiceAccountID(ctx, t, &provider, names.EC2, ""),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccProtoV5ProviderFactoriesInternal(ctx context.Context, t *testing.T, v **schema.Provider) map[string]func() (tfprotov5.ProviderServer, error) {
 	providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
 
@@ -718,6 +740,27 @@ func testAccCheckRegion(ctx context.Context, t *testing.T, p **schema.Provider,
 	}
 }
 
+// testAccCheckServiceAccountID checks the account ID for the specified service package.
+// An empty expected account ID is the provider's account ID.
+func testAccCheckServiceAccountID(ctx context.Context, t *testing.T, p **schema.Provider, servicePackageName, expectedAccountID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if p == nil || *p == nil || (*p).Meta() == nil || (*p).Meta().(*conns.AWSClient) == nil {
+			return fmt.Errorf("provider not initialized")
+		}
+
+		meta := (*p).Meta().(*conns.AWSClient)
+		if expectedAccountID == "" {
+			expectedAccountID = meta.AccountID(ctx)
+		}
+
+		if got := meta.AccountID(conns.NewResourceContext(ctx, servicePackageName, "Test", "aws_test")); got != expectedAccountID {
+			return fmt.Errorf("expected %s account ID (%s), got: %s", servicePackageName, expectedAccountID, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckSTSRegion(ctx context.Context, t *testing.T, p **schema.Provider, expectedRegion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if p == nil || *p == nil || (*p).Meta() == nil || (*p).Meta().(*conns.AWSClient) == nil {
@@ -1257,6 +1300,20 @@ provider "aws" {
 `, region))
 }
 
+func testAccProviderConfig_serviceAssumeRole(roleARN, service string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  skip_credentials_validation = true
+
+  service_assume_role {
+    role_arn = %[1]q
+    services = [%[2]q]
+  }
+}
+`, roleARN, service))
+}
+
 func testAccProviderConfig_stsRegion(region, stsRegion string) string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
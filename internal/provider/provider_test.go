@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
@@ -307,6 +308,95 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTagsRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []any
+		expectedRules []tftags.DefaultRule
+		expectError   bool
+	}{
+		"empty rule": {
+			tfList:        []any{nil},
+			expectedRules: []tftags.DefaultRule{},
+		},
+		"services and resource types": {
+			tfList: []any{
+				map[string]any{
+					"exclude_keys":   schema.NewSet(schema.HashString, []any{"backup-*"}),
+					"resource_types": schema.NewSet(schema.HashString, []any{"aws_db_*"}),
+					"services":       schema.NewSet(schema.HashString, []any{"rds", "prometheus"}),
+					"tags": map[string]any{
+						"backup-policy": "daily",
+					},
+				},
+			},
+			expectedRules: []tftags.DefaultRule{
+				{
+					ExcludeKeys:   []string{"backup-*"},
+					ResourceTypes: []string{"aws_db_*"},
+					Services:      []string{"amp", "rds"},
+					Tags: tftags.New(ctx, map[string]string{
+						"backup-policy": "daily",
+					}),
+				},
+			},
+		},
+		"invalid service": {
+			tfList: []any{
+				map[string]any{
+					"services": schema.NewSet(schema.HashString, []any{"not-a-service"}),
+				},
+			},
+			expectError: true,
+		},
+		"invalid glob pattern": {
+			tfList: []any{
+				map[string]any{
+					"resource_types": schema.NewSet(schema.HashString, []any{"aws_[db"}),
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, diags := expandDefaultTagsRules(ctx, cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("rule"), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, diags)
+			}
+			if testcase.expectError {
+				return
+			}
+
+			if len(rules) != len(testcase.expectedRules) {
+				t.Fatalf("expected %d rules, got %d", len(testcase.expectedRules), len(rules))
+			}
+			for i, rule := range rules {
+				expected := testcase.expectedRules[i]
+				slices.Sort(rule.Services)
+				if diff := cmp.Diff(rule.ExcludeKeys, expected.ExcludeKeys); diff != "" {
+					t.Errorf("unexpected exclude_keys diff (+wanted, -got): %s", diff)
+				}
+				if diff := cmp.Diff(rule.ResourceTypes, expected.ResourceTypes); diff != "" {
+					t.Errorf("unexpected resource_types diff (+wanted, -got): %s", diff)
+				}
+				if diff := cmp.Diff(rule.Services, expected.Services); diff != "" {
+					t.Errorf("unexpected services diff (+wanted, -got): %s", diff)
+				}
+				if diff := cmp.Diff(rule.Tags.Map(), expected.Tags.Map()); diff != "" {
+					t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
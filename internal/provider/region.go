@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionSchema returns the schema for the provider-managed per-resource `region` argument.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "Region where this resource will be managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds the per-resource `region` argument to the specified resource's schema.
+// Returns false if the schema already defines a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = s
+	}
+
+	return true
+}
+
+// injectResourceRegion adds the per-resource `region` argument to the specified resource
+// and defaults and validates it at plan time.
+// Returns false if the schema already defines a `region` attribute.
+func injectResourceRegion(r *schema.Resource) bool {
+	s := regionSchema()
+	// Resources without an Update handler must have all arguments ForceNew.
+	s.ForceNew = r.UpdateWithoutTimeout == nil
+
+	if !injectRegionAttribute(r, s) {
+		return false
+	}
+
+	if v := r.CustomizeDiff; v != nil {
+		r.CustomizeDiff = customdiff.Sequence(defaultRegionCustomizeDiff, v)
+	} else {
+		r.CustomizeDiff = defaultRegionCustomizeDiff
+	}
+
+	return true
+}
+
+// setOverrideRegion sets any per-resource Region override in Context.
+func setOverrideRegion(ctx context.Context, region string) {
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+}
+
+// defaultRegionCustomizeDiff defaults an unconfigured `region` argument to the provider's configured Region
+// and forces replacement if the effective Region changes.
+func defaultRegionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+		if err := d.SetNew(names.AttrRegion, meta.(*conns.AWSClient).DefaultRegion(ctx)); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	// Resources created before the `region` argument existed have no value in state.
+	if o, n := d.GetChange(names.AttrRegion); o.(string) != "" && o.(string) != n.(string) {
+		if err := d.ForceNew(names.AttrRegion); err != nil {
+			return fmt.Errorf("forcing new %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
+
+// importRegionStateContext handles import IDs of the form `<id>@<region>`.
+// The Region suffix is removed from the ID and set as the resource's `region` argument.
+func importRegionStateContext(ctx context.Context, d *schema.ResourceData) {
+	id, region, ok := types.SplitRegionSuffix(d.Id())
+	if !ok {
+		return
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrRegion, region)
+	setOverrideRegion(ctx, region)
+}
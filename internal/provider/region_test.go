@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectResourceRegion(t *testing.T) {
+	t.Parallel()
+
+	noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return nil
+	}
+
+	testCases := map[string]struct {
+		resource     func() *schema.Resource
+		wantInjected bool
+		wantForceNew bool
+	}{
+		"with Update": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					UpdateWithoutTimeout: noop,
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				}
+			},
+			wantInjected: true,
+			wantForceNew: false,
+		},
+		"without Update": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				}
+			},
+			wantInjected: true,
+			wantForceNew: true,
+		},
+		"SchemaFunc with Update": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					UpdateWithoutTimeout: noop,
+					SchemaFunc: func() map[string]*schema.Schema {
+						return map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						}
+					},
+				}
+			},
+			wantInjected: true,
+			wantForceNew: false,
+		},
+		"SchemaFunc without Update": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					SchemaFunc: func() map[string]*schema.Schema {
+						return map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						}
+					},
+				}
+			},
+			wantInjected: true,
+			wantForceNew: true,
+		},
+		"existing region attribute": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				}
+			},
+			wantInjected: false,
+			wantForceNew: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testCase.resource()
+			customizeDiff := r.CustomizeDiff
+
+			if got, want := injectResourceRegion(r), testCase.wantInjected; got != want {
+				t.Fatalf("injectResourceRegion() = %t, want %t", got, want)
+			}
+
+			s, ok := r.SchemaMap()[names.AttrRegion]
+			if !ok {
+				t.Fatalf("no %s attribute in schema", names.AttrRegion)
+			}
+			if got, want := s.ForceNew, testCase.wantForceNew; got != want {
+				t.Errorf("ForceNew = %t, want %t", got, want)
+			}
+
+			if !testCase.wantInjected {
+				if s.Computed {
+					t.Errorf("existing %s attribute modified", names.AttrRegion)
+				}
+				if r.CustomizeDiff != nil || customizeDiff != nil {
+					t.Errorf("unexpected CustomizeDiff")
+				}
+				return
+			}
+
+			if !s.Optional || !s.Computed {
+				t.Errorf("Optional = %t, Computed = %t, want Optional and Computed", s.Optional, s.Computed)
+			}
+			if r.CustomizeDiff == nil {
+				t.Errorf("no CustomizeDiff")
+			}
+			if err := r.InternalValidate(nil, true); err != nil {
+				t.Errorf("InternalValidate() = %s", err)
+			}
+		})
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	injectResourceRegion(r)
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+	meta := &conns.AWSClient{}
+
+	d := r.TestResourceData()
+	d.Set(names.AttrRegion, region)
+	d.SetId("id")
+
+	ctx, diags := regionInterceptor{}.run(ctx, d, meta, Before, Create, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := meta.Region(ctx), region; got != want {
+		t.Errorf("Region() = %q, want %q", got, want)
+	}
+
+	// Simulate the inner Create not setting the region.
+	d.Set(names.AttrRegion, "")
+
+	_, diags = regionInterceptor{}.run(ctx, d, meta, After, Create, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := d.Get(names.AttrRegion).(string), region; got != want {
+		t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+	}
+}
+
+func TestImportRegionStateContext(t *testing.T) {
+	t.Parallel()
+
+	const region = "eu-west-1" //lintignore:AWSAT003
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+	injectResourceRegion(r)
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+	meta := &conns.AWSClient{}
+
+	d := r.TestResourceData()
+	d.SetId("id@" + region)
+
+	importRegionStateContext(ctx, d)
+
+	if got, want := d.Id(), "id"; got != want {
+		t.Errorf("Id() = %q, want %q", got, want)
+	}
+	if got, want := d.Get(names.AttrRegion).(string), region; got != want {
+		t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+	}
+	if got, want := meta.Region(ctx), region; got != want {
+		t.Errorf("Region() = %q, want %q", got, want)
+	}
+}
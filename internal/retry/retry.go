@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 // Inspired by "github.com/ServiceWeaver/weaver/runtime/retry".
@@ -44,8 +46,9 @@ func Begin() *Retry {
 // It stops its sleep early and returns false if context becomes done.
 // If the return value is false, ctx.Err() is guaranteed to be non-nil.
 // The first call does not sleep.
+// No sleep occurs when VCR is replaying recorded interactions.
 func (r *Retry) Continue(ctx context.Context) bool {
-	if r.attempt != 0 {
+	if r.attempt != 0 && !vcr.IsReplaying() {
 		randomizedSleep(ctx, r.backoffDelay())
 	}
 	r.attempt++
@@ -879,7 +879,7 @@ func waitCertificateDomainValidationsAvailable(ctx context.Context, conn *acm.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.CertificateDetail); ok {
 		return output, err
@@ -912,7 +912,7 @@ func waitCertificateRenewed(ctx context.Context, conn *acm.Client, arn string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RenewalSummary); ok {
 		if output.RenewalStatus == types.RenewalStatusFailed {
@@ -175,7 +175,7 @@ func waitCertificateIssued(ctx context.Context, conn *acm.Client, arn string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.CertificateDetail); ok {
 		switch output.Status {
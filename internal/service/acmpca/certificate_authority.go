@@ -599,7 +599,7 @@ func waitCertificateAuthorityCreated(ctx context.Context, conn *acmpca.Client, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.CertificateAuthority); ok {
 		if output.Status == types.CertificateAuthorityStatusFailed {
@@ -234,7 +234,7 @@ func waitRuleGroupNamespaceCreated(ctx context.Context, conn *amp.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -254,7 +254,7 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *amp.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -274,7 +274,7 @@ func waitRuleGroupNamespaceDeleted(ctx context.Context, conn *amp.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -478,7 +478,7 @@ func waitScraperCreated(ctx context.Context, conn *amp.Client, id string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*awstypes.ScraperDescription); ok {
 		return out, err
@@ -496,7 +496,7 @@ func waitScraperDeleted(ctx context.Context, conn *amp.Client, id string, timeou
 		Delay:   8 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ScraperDescription); ok {
 		return output, err
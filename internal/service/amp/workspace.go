@@ -337,7 +337,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -357,7 +357,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -377,7 +377,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -438,7 +438,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.LoggingConfigurationStatusCodeCreationFailed {
@@ -462,7 +462,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.LoggingConfigurationStatusCodeUpdateFailed {
@@ -486,7 +486,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		return output, err
@@ -225,7 +225,7 @@ func waitVPCLinkAvailable(ctx context.Context, conn *apigatewayv2.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.VpcLinkStatusMessage)))
@@ -247,7 +247,7 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigatewayv2.Client, vpcLinkI
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.VpcLinkStatusMessage)))
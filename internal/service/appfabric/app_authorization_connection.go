@@ -253,7 +253,7 @@ func waitConnectAppAuthorizationCreated(ctx context.Context, conn *appfabric.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*awstypes.AppAuthorization); ok {
 		return out, err
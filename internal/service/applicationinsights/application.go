@@ -272,7 +272,7 @@ func waitApplicationCreated(ctx context.Context, conn *applicationinsights.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationInfo); ok {
 		return output, err
@@ -292,7 +292,7 @@ func waitApplicationTerminated(ctx context.Context, conn *applicationinsights.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationInfo); ok {
 		return output, err
@@ -4,25 +4,25 @@
 package appmesh
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appmesh"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -46,12 +46,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -59,14 +59,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
 }
 
-func sweepMeshes(region string) error {
-	ctx := sweep.Context(region)
+func sweepMeshes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -106,8 +105,7 @@ func sweepMeshes(region string) error {
 	return nil
 }
 
-func sweepVirtualGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -171,8 +169,7 @@ func sweepVirtualGateways(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualNodes(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualNodes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -236,8 +233,7 @@ func sweepVirtualNodes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualRouters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualRouters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -301,8 +297,7 @@ func sweepVirtualRouters(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -366,8 +361,7 @@ func sweepVirtualServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayRoutes(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayRoutes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -453,8 +447,7 @@ func sweepGatewayRoutes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoutes(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoutes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -222,7 +222,7 @@ func waitVPCConnectorCreated(ctx context.Context, conn *apprunner.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcConnector); ok {
 		return output, err
@@ -242,7 +242,7 @@ func waitVPCConnectorDeleted(ctx context.Context, conn *apprunner.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcConnector); ok {
 		return output, err
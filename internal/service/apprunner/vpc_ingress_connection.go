@@ -238,7 +238,7 @@ func waitVPCIngressConnectionCreated(ctx context.Context, conn *apprunner.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcIngressConnection); ok {
 		return output, err
@@ -258,7 +258,7 @@ func waitVPCIngressConnectionDeleted(ctx context.Context, conn *apprunner.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcIngressConnection); ok {
 		return output, err
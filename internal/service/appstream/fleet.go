@@ -292,7 +292,7 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	var err error
 	var output *appstream.CreateFleetOutput
-	err = retry.RetryContext(ctx, fleetOperationTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, fleetOperationTimeout, func() *retry.RetryError {
 		output, err = conn.CreateFleet(ctx, input)
 		if err != nil {
 			if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.ConcurrentModificationException](err) {
@@ -54,7 +54,7 @@ func resourceFleetStackAssociationCreate(ctx context.Context, d *schema.Resource
 		StackName: aws.String(d.Get("stack_name").(string)),
 	}
 
-	err := retry.RetryContext(ctx, fleetOperationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, fleetOperationTimeout, func() *retry.RetryError {
 		_, err := conn.AssociateFleet(ctx, input)
 		if err != nil {
 			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
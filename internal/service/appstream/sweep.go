@@ -4,41 +4,40 @@
 package appstream
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
 }
 
-func sweepDirectoryConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectoryConfigs(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Directory Config sweep for region: %s", region)
 		return nil
@@ -85,8 +84,7 @@ func sweepDirectoryConfigs(region string) error {
 	return nil
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Fleet sweep for region: %s", region)
 		return nil
@@ -133,8 +131,7 @@ func sweepFleets(region string) error {
 	return nil
 }
 
-func sweepImageBuilders(region string) error {
-	ctx := sweep.Context(region)
+func sweepImageBuilders(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Image Builder sweep for region: %s", region)
 		return nil
@@ -181,8 +178,7 @@ func sweepImageBuilders(region string) error {
 	return nil
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+func sweepStacks(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID {
 		log.Printf("[WARN] Skipping AppStream Stack sweep for region: %s", region)
 		return nil
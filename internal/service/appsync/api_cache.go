@@ -238,7 +238,7 @@ func waitAPICacheAvailable(ctx context.Context, conn *appsync.Client, id string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApiCache); ok {
 		return output, err
@@ -258,7 +258,7 @@ func waitAPICacheDeleted(ctx context.Context, conn *appsync.Client, id string) (
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApiCache); ok {
 		return output, err
@@ -195,7 +195,7 @@ func waitDomainNameAPIAssociation(ctx context.Context, conn *appsync.Client, id
 		Timeout: domainNameAPIAssociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApiAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DeploymentDetail)))
@@ -216,7 +216,7 @@ func waitDomainNameAPIDisassociation(ctx context.Context, conn *appsync.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApiAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DeploymentDetail)))
@@ -643,7 +643,7 @@ func waitSchemaCreated(ctx context.Context, conn *appsync.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appsync.GetSchemaCreationStatusOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Details)))
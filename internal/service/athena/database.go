@@ -306,7 +306,7 @@ func queryExecutionResult(ctx context.Context, conn *athena.Client, qeid string)
 		Delay:      3 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err := executionStateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, executionStateConf)
 
 	if err != nil {
 		return nil, err
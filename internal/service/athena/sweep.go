@@ -4,19 +4,19 @@
 package athena
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/athena"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_athena_data_catalog",
 		F:    sweepDataCatalogs,
 		Dependencies: []string{
@@ -24,12 +24,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_athena_database", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_athena_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_athena_workgroup",
 		F:    sweepWorkGroups,
 		Dependencies: []string{
@@ -38,8 +38,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepDataCatalogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepDataCatalogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -86,8 +85,7 @@ func sweepDataCatalogs(region string) error {
 	return nil
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -151,8 +149,7 @@ func sweepDatabases(region string) error {
 	return nil
 }
 
-func sweepWorkGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,6 +4,7 @@
 package auditmanager
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -19,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_auditmanager_assessment",
 		F:    sweepAssessments,
 		Dependencies: []string{
@@ -29,23 +29,23 @@ func RegisterSweepers() {
 			"aws_s3_bucket",
 		},
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_auditmanager_assessment_delegation",
 		F:    sweepAssessmentDelegations,
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_auditmanager_assessment_report",
 		F:    sweepAssessmentReports,
 	})
-	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_auditmanager_control",
 		F:    sweepControls,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_auditmanager_framework",
 		F:    sweepFrameworks,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_auditmanager_framework_share",
 		F:    sweepFrameworkShares,
 	})
@@ -61,8 +61,7 @@ func isCompleteSetupError(err error) bool {
 	return errors.As(err, &ade)
 }
 
-func sweepAssessments(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssessments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -101,8 +100,7 @@ func sweepAssessments(region string) error {
 	return nil
 }
 
-func sweepAssessmentDelegations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssessmentDelegations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -140,8 +138,7 @@ func sweepAssessmentDelegations(region string) error {
 	return nil
 }
 
-func sweepAssessmentReports(region string) error {
-	ctx := sweep.Context(region)
+func sweepAssessmentReports(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -181,8 +178,7 @@ func sweepAssessmentReports(region string) error {
 	return nil
 }
 
-func sweepControls(region string) error {
-	ctx := sweep.Context(region)
+func sweepControls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -221,8 +217,7 @@ func sweepControls(region string) error {
 	return nil
 }
 
-func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepFrameworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -261,8 +256,7 @@ func sweepFrameworks(region string) error {
 	return nil
 }
 
-func sweepFrameworkShares(region string) error {
-	ctx := sweep.Context(region)
+func sweepFrameworkShares(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
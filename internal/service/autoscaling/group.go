@@ -2552,7 +2552,7 @@ func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.Client, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(struct{ err error }); ok {
 		tfresource.SetLastError(err, output.err)
@@ -2568,7 +2568,7 @@ func waitGroupDrained(ctx context.Context, conn *autoscaling.Client, name string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AutoScalingGroup); ok {
 		return output, err
@@ -2584,7 +2584,7 @@ func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerState); ok {
 		return output, err
@@ -2600,7 +2600,7 @@ func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.Client, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerState); ok {
 		return output, err
@@ -2616,7 +2616,7 @@ func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2632,7 +2632,7 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2648,7 +2648,7 @@ func waitTrafficSourcesCreated(ctx context.Context, conn *autoscaling.Client, as
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -2664,7 +2664,7 @@ func waitTrafficSourcesDeleted(ctx context.Context, conn *autoscaling.Client, as
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -2699,7 +2699,7 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
 		return output, err
@@ -2716,7 +2716,7 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WarmPoolConfiguration); ok {
 		return output, err
@@ -2732,7 +2732,7 @@ func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.DescribeWarmPoolOutput); ok {
 		return output, err
@@ -217,7 +217,7 @@ func waitTrafficSourceAttachmentCreated(ctx context.Context, conn *autoscaling.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -234,7 +234,7 @@ func waitTrafficSourceAttachmentDeleted(ctx context.Context, conn *autoscaling.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -323,7 +323,7 @@ func waitFrameworkCreated(ctx context.Context, conn *backup.Client, name string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -340,7 +340,7 @@ func waitFrameworkUpdated(ctx context.Context, conn *backup.Client, name string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -357,7 +357,7 @@ func waitFrameworkDeleted(ctx context.Context, conn *backup.Client, name string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
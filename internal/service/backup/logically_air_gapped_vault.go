@@ -252,7 +252,7 @@ func waitLogicallyAirGappedVaultCreated(ctx context.Context, conn *backup.Client
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeBackupVaultOutput); ok {
 		return output, err
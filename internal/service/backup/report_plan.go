@@ -433,7 +433,7 @@ func waitReportPlanCreated(ctx context.Context, conn *backup.Client, name string
 		Refresh: statusReportPlan(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReportPlan); ok {
 		return output, err
@@ -450,7 +450,7 @@ func waitReportPlanUpdated(ctx context.Context, conn *backup.Client, name string
 		Refresh: statusReportPlan(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReportPlan); ok {
 		return output, err
@@ -467,7 +467,7 @@ func waitReportPlanDeleted(ctx context.Context, conn *backup.Client, name string
 		Refresh: statusReportPlan(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReportPlan); ok {
 		return output, err
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -70,6 +71,9 @@ func (r *restoreTestingPlanResource) Schema(ctx context.Context, request resourc
 			},
 			names.AttrScheduleExpression: schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					validators.ScheduleExpression(schedule.WithTypes(schedule.TypeCron)),
+				},
 			},
 			"schedule_expression_timezone": schema.StringAttribute{
 				Computed: true,
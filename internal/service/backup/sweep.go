@@ -4,13 +4,13 @@
 package backup
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -18,12 +18,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_backup_framework", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_framework",
 		F:    sweepFrameworks,
 	})
 
-	resource.AddTestSweepers("aws_backup_plan", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_plan",
 		F:    sweepPlans,
 		Dependencies: []string{
@@ -31,17 +31,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_backup_selection", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_selection",
 		F:    sweepSelections,
 	})
 
-	resource.AddTestSweepers("aws_backup_report_plan", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_report_plan",
 		F:    sweepReportPlans,
 	})
 
-	resource.AddTestSweepers("aws_backup_restore_testing_plan", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_restore_testing_plan",
 		F:    sweepRestoreTestingPlans,
 		Dependencies: []string{
@@ -49,27 +49,27 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_backup_restore_testing_selection", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_restore_testing_selection",
 		F:    sweepRestoreTestingSelections,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -80,8 +80,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepFrameworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -119,8 +118,7 @@ func sweepFrameworks(region string) error {
 	return nil
 }
 
-func sweepPlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -165,8 +163,7 @@ func sweepPlans(region string) error {
 	return nil
 }
 
-func sweepSelections(region string) error {
-	ctx := sweep.Context(region)
+func sweepSelections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -227,8 +224,7 @@ func sweepSelections(region string) error {
 	return nil
 }
 
-func sweepReportPlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepReportPlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -266,8 +262,7 @@ func sweepReportPlans(region string) error {
 	return nil
 }
 
-func sweepRestoreTestingPlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestoreTestingPlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -302,8 +297,7 @@ func sweepRestoreTestingPlans(region string) error {
 	return nil
 }
 
-func sweepRestoreTestingSelections(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestoreTestingSelections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -355,8 +349,7 @@ func sweepRestoreTestingSelections(region string) error {
 	return nil
 }
 
-func sweepVaultLockConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultLockConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -394,8 +387,7 @@ func sweepVaultLockConfigurations(region string) error {
 	return nil
 }
 
-func sweepVaultNotifications(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultNotifications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -433,8 +425,7 @@ func sweepVaultNotifications(region string) error {
 	return nil
 }
 
-func sweepVaultPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -472,8 +463,7 @@ func sweepVaultPolicies(region string) error {
 	return nil
 }
 
-func sweepVaults(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaults(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -308,7 +308,7 @@ func waitRecoveryPointDeleted(ctx context.Context, conn *backup.Client, backupVa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeRecoveryPointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -331,7 +331,7 @@ func waitJobCompleted(ctx context.Context, conn *backup.Client, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeBackupJobOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
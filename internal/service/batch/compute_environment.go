@@ -753,7 +753,7 @@ func waitComputeEnvironmentCreated(ctx context.Context, conn *batch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ComputeEnvironmentDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -772,7 +772,7 @@ func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ComputeEnvironmentDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -791,7 +791,7 @@ func waitComputeEnvironmentDeleted(ctx context.Context, conn *batch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ComputeEnvironmentDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -488,7 +488,7 @@ func waitJobQueueCreated(ctx context.Context, conn *batch.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.JobQueueDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -509,7 +509,7 @@ func waitJobQueueUpdated(ctx context.Context, conn *batch.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.JobQueueDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -530,7 +530,7 @@ func waitJobQueueDeleted(ctx context.Context, conn *batch.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.JobQueueDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
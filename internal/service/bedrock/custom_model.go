@@ -574,7 +574,7 @@ func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
@@ -593,7 +593,7 @@ func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
@@ -565,7 +565,7 @@ func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
 		return out, err
 	}
@@ -583,7 +583,7 @@ func waitGuardrailUpdated(ctx context.Context, conn *bedrock.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
 		return out, err
 	}
@@ -599,7 +599,7 @@ func waitGuardrailDeleted(ctx context.Context, conn *bedrock.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
 		return out, err
 	}
@@ -296,7 +296,7 @@ func waitInferenceProfileCreated(ctx context.Context, conn *bedrock.Client, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetInferenceProfileOutput); ok {
 		return out, err
 	}
@@ -312,7 +312,7 @@ func waitInferenceProfileDeleted(ctx context.Context, conn *bedrock.Client, id s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetInferenceProfileOutput); ok {
 		return out, err
 	}
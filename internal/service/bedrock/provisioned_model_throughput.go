@@ -254,7 +254,7 @@ func waitProvisionedModelThroughputCreated(ctx context.Context, conn *bedrock.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
@@ -325,7 +325,7 @@ func waitAgentAliasCreated(ctx context.Context, conn *bedrockagent.Client, agent
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AgentAlias); ok {
 		return output, err
@@ -342,7 +342,7 @@ func waitAgentAliasUpdated(ctx context.Context, conn *bedrockagent.Client, agent
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AgentAlias); ok {
 		return output, err
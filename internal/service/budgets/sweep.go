@@ -4,24 +4,24 @@
 package budgets
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/budgets"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActions,
 	})
 
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_budgets_budget",
 		F:    sweepBudgets,
 		Dependencies: []string{
@@ -30,8 +30,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepBudgetActions(region string) error {
-	ctx := sweep.Context(region)
+func sweepBudgetActions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -74,8 +73,7 @@ func sweepBudgetActions(region string) error {
 	return nil
 }
 
-func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
-	ctx := sweep.Context(region)
+func sweepBudgets(ctx context.Context, region string) error { // nosemgrep:ci.budgets-in-func-name
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -4,26 +4,25 @@
 package chime
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/chimesdkvoice"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_chime_voice_connector", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_chime_voice_connector",
 		F:    sweepVoiceConnectors,
 	})
 }
 
-func sweepVoiceConnectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepVoiceConnectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
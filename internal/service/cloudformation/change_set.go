@@ -70,7 +70,7 @@ func waitChangeSetCreated(ctx context.Context, conn *cloudformation.Client, stac
 		Refresh: statusChangeSet(ctx, conn, stackID, changeSetName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
 		if output.Status == awstypes.ChangeSetStatusFailed {
@@ -530,7 +530,7 @@ func waitStackSetCreated(ctx context.Context, conn *cloudformation.Client, name,
 		Delay:   15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StackSet); ok {
 		return output, err
@@ -625,7 +625,7 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 		Delay:   stackSetOperationDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StackSetOperation); ok {
 		if output.Status == awstypes.StackSetOperationStatusFailed {
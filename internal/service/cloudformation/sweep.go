@@ -4,29 +4,31 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"slices"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudformation_stack_set_instance",
 		F:    sweepStackSetInstances,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudformation_stack_set",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -34,7 +36,7 @@ func RegisterSweepers() {
 		F: sweepStackSets,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudformation_stack",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -43,8 +45,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepStackSetInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepStackSetInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -131,8 +132,7 @@ func sweepStackSetInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepStackSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepStackSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -194,8 +194,7 @@ func sweepStackSets(region string) error {
 	return nil
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+func sweepStacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -227,24 +226,15 @@ func sweepStacks(region string) error {
 
 		for _, v := range page.StackSummaries {
 			name := aws.ToString(v.StackName)
-			inputU := &cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
-
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, inputU)
-
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
-			}
-
 			r := resourceStack()
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, stackSweeper{
+				conn:      conn,
+				name:      name,
+				sweepable: sweep.NewSweepResource(r, d, client),
+			})
 		}
 	}
 
@@ -256,3 +246,35 @@ func sweepStacks(region string) error {
 
 	return nil
 }
+
+type stackSweeper struct {
+	conn      *cloudformation.Client
+	name      string
+	sweepable sweep.Sweepable
+}
+
+func (ss stackSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	input := &cloudformation.UpdateTerminationProtectionInput{
+		EnableTerminationProtection: aws.Bool(false),
+		StackName:                   aws.String(ss.name),
+	}
+
+	log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", ss.name)
+	_, err := ss.conn.UpdateTerminationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling termination protection for CloudFormation Stack (%s): %w", ss.name, err)
+	}
+
+	return ss.sweepable.Delete(ctx, timeout, optFns...)
+}
+
+func (ss stackSweeper) Describe(ctx context.Context) (sweep.Candidate, error) {
+	if v, ok := ss.sweepable.(interface {
+		Describe(context.Context) (sweep.Candidate, error)
+	}); ok {
+		return v.Describe(ctx)
+	}
+
+	return sweep.Candidate{ID: ss.name}, nil
+}
@@ -396,7 +396,7 @@ func waitTypeRegistrationProgressStatusComplete(ctx context.Context, conn *cloud
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeTypeRegistrationOutput); ok {
 		return output, err
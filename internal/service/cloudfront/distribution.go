@@ -910,6 +910,15 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 		input.DistributionConfigWithTags.Tags.Items = tags
 	}
 
+	// Limit the number of distributions concurrently replicating Lambda@Edge functions.
+	if distributionConfigHasLambdaFunctionAssociations(input.DistributionConfigWithTags.DistributionConfig) {
+		release, err := acquireLambdaEdgeReplication(ctx, meta.(*conns.AWSClient), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudFront Distribution: %s", err)
+		}
+		defer release()
+	}
+
 	// ACM and IAM certificate eventual consistency.
 	// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
 	const (
@@ -1037,6 +1046,15 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 			IfMatch:            aws.String(d.Get("etag").(string)),
 		}
 
+		// Limit the number of distributions concurrently replicating Lambda@Edge functions.
+		if distributionConfigHasLambdaFunctionAssociations(input.DistributionConfig) {
+			release, err := acquireLambdaEdgeReplication(ctx, meta.(*conns.AWSClient), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution (%s): %s", d.Id(), err)
+			}
+			defer release()
+		}
+
 		// ACM and IAM certificate eventual consistency.
 		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
 		const (
@@ -1296,7 +1314,7 @@ func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.GetDistributionOutput); ok {
 		return output, err
@@ -1315,7 +1333,7 @@ func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id st
 		Delay:      15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.GetDistributionOutput); ok {
 		return output, err
@@ -2821,3 +2839,33 @@ func flattenSigners(apiObjects []awstypes.Signer) []interface{} {
 
 	return tfList
 }
+
+// acquireLambdaEdgeReplication waits for Lambda@Edge replication capacity.
+// The wait is bounded to half of the specified operation timeout so that time remains for the operation itself.
+func acquireLambdaEdgeReplication(ctx context.Context, c *conns.AWSClient, timeout time.Duration) (func(), error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout/2)
+	defer cancel()
+
+	return c.AcquireConcurrency(ctx, concurrencyLimitLambdaEdgeReplication)
+}
+
+// distributionConfigHasLambdaFunctionAssociations returns whether any of the distribution's cache behaviors are associated with Lambda@Edge functions.
+func distributionConfigHasLambdaFunctionAssociations(apiObject *awstypes.DistributionConfig) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	if v := apiObject.DefaultCacheBehavior; v != nil && v.LambdaFunctionAssociations != nil && len(v.LambdaFunctionAssociations.Items) > 0 {
+		return true
+	}
+
+	if v := apiObject.CacheBehaviors; v != nil {
+		for _, v := range v.Items {
+			if v.LambdaFunctionAssociations != nil && len(v.LambdaFunctionAssociations.Items) > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Named concurrency limits.
+const (
+	concurrencyLimitLambdaEdgeReplication = "cloudfront.lambda_edge_replication"
+)
+
+func (p *servicePackage) ConcurrencyLimits(context.Context) []conns.ConcurrencyLimit {
+	return []conns.ConcurrencyLimit{
+		{Name: concurrencyLimitLambdaEdgeReplication, Limit: 4},
+	}
+}
@@ -380,7 +380,7 @@ func waitVPCOriginDeployed(ctx context.Context, conn *cloudfront.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.GetVpcOriginOutput); ok {
 		return output, err
@@ -397,7 +397,7 @@ func waitVPCOriginDeleted(ctx context.Context, conn *cloudfront.Client, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.GetVpcOriginOutput); ok {
 		return output, err
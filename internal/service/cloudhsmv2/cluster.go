@@ -310,7 +310,7 @@ func waitClusterActive(ctx context.Context, conn *cloudhsmv2.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
@@ -331,7 +331,7 @@ func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
@@ -352,7 +352,7 @@ func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2.Client, id s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
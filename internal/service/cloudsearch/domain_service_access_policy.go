@@ -213,7 +213,7 @@ func waitAccessPolicyActive(ctx context.Context, conn *cloudsearch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AccessPoliciesStatus); ok {
 		return output, err
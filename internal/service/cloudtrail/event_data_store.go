@@ -462,7 +462,7 @@ func waitEventDataStoreCreated(ctx context.Context, conn *cloudtrail.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -479,7 +479,7 @@ func waitEventDataStoreUpdated(ctx context.Context, conn *cloudtrail.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -496,7 +496,7 @@ func waitEventDataStoreDeleted(ctx context.Context, conn *cloudtrail.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -513,7 +513,7 @@ func waitEventDataStoreIngestionStarted(ctx context.Context, conn *cloudtrail.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -530,7 +530,7 @@ func waitEventDataStoreIngestionStopped(ctx context.Context, conn *cloudtrail.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -4,31 +4,30 @@
 package cloudtrail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudtrail",
 		F:    sweepTrails,
 	})
 
-	resource.AddTestSweepers("aws_cloudtrail_event_data_store", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudtrail_event_data_store",
 		F:    sweepEventDataStores,
 	})
 }
 
-func sweepTrails(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrails(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -90,8 +89,7 @@ func sweepTrails(region string) error {
 	return nil
 }
 
-func sweepEventDataStores(region string) error {
-	ctx := sweep.Context(region)
+func sweepEventDataStores(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -4,41 +4,40 @@
 package cloudwatch
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudwatch_composite_alarm",
 		F:    sweepCompositeAlarms,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_dashboard", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudwatch_dashboard",
 		F:    sweepDashboards,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_metric_alarm", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudwatch_metric_alarm",
 		F:    sweepMetricAlarms,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_metric_stream", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_cloudwatch_metric_stream",
 		F:    sweepMetricStreams,
 	})
 }
 
-func sweepCompositeAlarms(region string) error {
-	ctx := sweep.Context(region)
+func sweepCompositeAlarms(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -80,8 +79,7 @@ func sweepCompositeAlarms(region string) error {
 	return nil
 }
 
-func sweepDashboards(region string) error {
-	ctx := sweep.Context(region)
+func sweepDashboards(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -121,8 +119,7 @@ func sweepDashboards(region string) error {
 	return nil
 }
 
-func sweepMetricAlarms(region string) error {
-	ctx := sweep.Context(region)
+func sweepMetricAlarms(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -164,8 +161,7 @@ func sweepMetricAlarms(region string) error {
 	return nil
 }
 
-func sweepMetricStreams(region string) error {
-	ctx := sweep.Context(region)
+func sweepMetricStreams(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
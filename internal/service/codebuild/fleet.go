@@ -519,7 +519,7 @@ func waitFleetCreated(ctx context.Context, conn *codebuild.Client, arn string, t
 		Delay:      15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Fleet); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -540,7 +540,7 @@ func waitFleetUpdated(ctx context.Context, conn *codebuild.Client, arn string, t
 		Delay:      15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Fleet); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -561,7 +561,7 @@ func waitFleetDeleted(ctx context.Context, conn *codebuild.Client, arn string, t
 		Delay:      15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Fleet); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
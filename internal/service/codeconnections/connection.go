@@ -287,7 +287,7 @@ func waitConnectionCreated(ctx context.Context, conn *codeconnections.Client, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Connection); ok {
 		return out, err
 	}
@@ -303,7 +303,7 @@ func waitConnectionDeleted(ctx context.Context, conn *codeconnections.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Connection); ok {
 		return out, err
 	}
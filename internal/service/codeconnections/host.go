@@ -336,7 +336,7 @@ func waitHostPendingOrAvailable(ctx context.Context, conn *codeconnections.Clien
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Host); ok {
 		return out, err
 	}
@@ -352,7 +352,7 @@ func waitHostDeleted(ctx context.Context, conn *codeconnections.Client, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Host); ok {
 		return out, err
 	}
@@ -650,7 +650,7 @@ func waitDocumentClassifierCreated(ctx context.Context, conn *comprehend.Client,
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		if output.Status == types.ModelStatusInError {
 			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
@@ -671,7 +671,7 @@ func waitDocumentClassifierStopped(ctx context.Context, conn *comprehend.Client,
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		return out, err
 	}
@@ -690,7 +690,7 @@ func waitDocumentClassifierDeleted(ctx context.Context, conn *comprehend.Client,
 		Timeout:        timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		return out, err
 	}
@@ -226,7 +226,7 @@ func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
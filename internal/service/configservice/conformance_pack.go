@@ -316,7 +316,7 @@ func waitConformancePackCreated(ctx context.Context, conn *configservice.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConformancePackStatusDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConformancePackStatusReason)))
@@ -335,7 +335,7 @@ func waitConformancePackDeleted(ctx context.Context, conn *configservice.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConformancePackStatusDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConformancePackStatusReason)))
@@ -453,7 +453,7 @@ func waitOrganizationConformancePackCreated(ctx context.Context, conn *configser
 		NotFoundChecks: 10,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConformancePackStatus); ok {
 		tfresource.SetLastError(err, organizationConformancePackStatusError(ctx, conn, output))
@@ -473,7 +473,7 @@ func waitOrganizationConformancePackUpdated(ctx context.Context, conn *configser
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConformancePackStatus); ok {
 		tfresource.SetLastError(err, organizationConformancePackStatusError(ctx, conn, output))
@@ -494,7 +494,7 @@ func waitOrganizationConformancePackDeleted(ctx context.Context, conn *configser
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConformancePackStatus); ok {
 		tfresource.SetLastError(err, organizationConformancePackStatusError(ctx, conn, output))
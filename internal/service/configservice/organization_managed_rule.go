@@ -480,7 +480,7 @@ func waitOrganizationConfigRuleCreated(ctx context.Context, conn *configservice.
 		NotFoundChecks: 10,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConfigRuleStatus); ok {
 		tfresource.SetLastError(err, organizationConfigRuleStatusError(ctx, conn, output))
@@ -500,7 +500,7 @@ func waitOrganizationConfigRuleUpdated(ctx context.Context, conn *configservice.
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConfigRuleStatus); ok {
 		tfresource.SetLastError(err, organizationConfigRuleStatusError(ctx, conn, output))
@@ -521,7 +521,7 @@ func waitOrganizationConfigRuleDeleted(ctx context.Context, conn *configservice.
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConfigRuleStatus); ok {
 		tfresource.SetLastError(err, organizationConfigRuleStatusError(ctx, conn, output))
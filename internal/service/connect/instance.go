@@ -406,7 +406,7 @@ func waitInstanceCreated(ctx context.Context, conn *connect.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if statusReason := output.StatusReason; statusReason != nil {
@@ -427,7 +427,7 @@ func waitInstanceDeleted(ctx context.Context, conn *connect.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if statusReason := output.StatusReason; statusReason != nil {
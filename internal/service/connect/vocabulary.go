@@ -282,7 +282,7 @@ func waitVocabularyCreated(ctx context.Context, conn *connect.Client, instanceID
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Vocabulary); ok {
 		if state := output.State; state == awstypes.VocabularyStateCreationFailed {
@@ -303,7 +303,7 @@ func waitVocabularyDeleted(ctx context.Context, conn *connect.Client, instanceID
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Vocabulary); ok {
 		return output, err
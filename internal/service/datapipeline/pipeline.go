@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -162,7 +163,7 @@ func waitForDeletion(ctx context.Context, conn *datapipeline.Client, pipelineID
 	params := &datapipeline.DescribePipelinesInput{
 		PipelineIds: []string{pipelineID},
 	}
-	return retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+	return tfresource.Retry(ctx, 10*time.Minute, func() *retry.RetryError {
 		_, err := conn.DescribePipelines(ctx, params)
 		if errs.IsA[*awstypes.PipelineNotFoundException](err) || errs.IsA[*awstypes.PipelineDeletedException](err) {
 			return nil
@@ -156,7 +156,7 @@ func resourcePipelineDefinitionPut(ctx context.Context, d *schema.ResourceData,
 
 	var err error
 	var output *datapipeline.PutPipelineDefinitionOutput
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		output, err = conn.PutPipelineDefinition(ctx, input)
 		if err != nil {
 			if errs.IsA[*awstypes.InternalServiceError](err) {
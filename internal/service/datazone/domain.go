@@ -376,7 +376,7 @@ func waitDomainCreated(ctx context.Context, conn *datazone.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*datazone.GetDomainOutput); ok {
 		return out, err
 	}
@@ -392,7 +392,7 @@ func waitDomainDeleted(ctx context.Context, conn *datazone.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*datazone.GetDomainOutput); ok {
 		return out, err
 	}
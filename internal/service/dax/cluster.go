@@ -261,7 +261,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// IAM roles take some time to propagate
 	var resp *dax.CreateClusterOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		resp, err = conn.CreateCluster(ctx, input)
 		if errs.IsA[*awstypes.InvalidParameterValueException](err) {
@@ -297,7 +297,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
 	}
@@ -463,7 +463,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:      30 * time.Second,
 		}
 
-		_, sterr := stateConf.WaitForStateContext(ctx)
+		_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 		if sterr != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to update: %s", d.Id(), sterr)
 		}
@@ -498,7 +498,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	req := &dax.DeleteClusterInput{
 		ClusterName: aws.String(d.Id()),
 	}
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteCluster(ctx, req)
 		if errs.IsA[*awstypes.InvalidClusterStateFault](err) {
 			return retry.RetryableError(err)
@@ -533,7 +533,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      30 * time.Second,
 	}
 
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to delete: %s", d.Id(), sterr)
 	}
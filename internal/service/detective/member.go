@@ -291,7 +291,7 @@ func waitMemberInvited(ctx context.Context, conn *detective.Client, graphARN, ad
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.MemberDetail); ok {
 		return output, err
@@ -235,7 +235,7 @@ func waitBGPPeerCreated(ctx context.Context, conn *directconnect.Client, vifID s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.BGPPeer); ok {
 		return output, err
@@ -254,7 +254,7 @@ func waitBGPPeerDeleted(ctx context.Context, conn *directconnect.Client, vifID s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.BGPPeer); ok {
 		return output, err
@@ -446,7 +446,7 @@ func waitConnectionDeleted(ctx context.Context, conn *directconnect.Client, id s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Connection); ok {
 		return output, err
@@ -92,7 +92,7 @@ func waitConnectionConfirmed(ctx context.Context, conn *directconnect.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Connection); ok {
 		return output, err
@@ -240,7 +240,7 @@ func waitGatewayCreated(ctx context.Context, conn *directconnect.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
@@ -259,7 +259,7 @@ func waitGatewayDeleted(ctx context.Context, conn *directconnect.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
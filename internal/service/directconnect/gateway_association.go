@@ -391,7 +391,7 @@ func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
@@ -410,7 +410,7 @@ func waitGatewayAssociationUpdated(ctx context.Context, conn *directconnect.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
@@ -429,7 +429,7 @@ func waitGatewayAssociationDeleted(ctx context.Context, conn *directconnect.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
@@ -254,7 +254,7 @@ func waitHostedConnectionDeleted(ctx context.Context, conn *directconnect.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Connection); ok {
 		return output, err
@@ -283,7 +283,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *dms.Client, name st
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -302,7 +302,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *dms.Client, name st
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -321,7 +321,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *dms.Client, name st
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -493,7 +493,7 @@ func waitReplicationRunning(ctx context.Context, conn *dms.Client, arn string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Replication); ok {
 		setLastReplicationError(err, output)
@@ -513,7 +513,7 @@ func waitReplicationStopped(ctx context.Context, conn *dms.Client, arn string, t
 		Delay:      60 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Replication); ok {
 		setLastReplicationError(err, output)
@@ -533,7 +533,7 @@ func waitReplicationDeleted(ctx context.Context, conn *dms.Client, arn string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Replication); ok {
 		setLastReplicationError(err, output)
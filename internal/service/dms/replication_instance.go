@@ -406,7 +406,7 @@ func waitReplicationInstanceCreated(ctx context.Context, conn *dms.Client, id st
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationInstance); ok {
 		return output, err
@@ -425,7 +425,7 @@ func waitReplicationInstanceUpdated(ctx context.Context, conn *dms.Client, id st
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationInstance); ok {
 		return output, err
@@ -444,7 +444,7 @@ func waitReplicationInstanceDeleted(ctx context.Context, conn *dms.Client, id st
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationInstance); ok {
 		return output, err
@@ -436,7 +436,7 @@ func waitReplicationTaskDeleted(ctx context.Context, conn *dms.Client, id string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -456,7 +456,7 @@ func waitReplicationTaskModified(ctx context.Context, conn *dms.Client, id strin
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -476,7 +476,7 @@ func waitReplicationTaskMoved(ctx context.Context, conn *dms.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -496,7 +496,7 @@ func waitReplicationTaskReady(ctx context.Context, conn *dms.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -519,7 +519,7 @@ func waitReplicationTaskRunning(ctx context.Context, conn *dms.Client, id string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -543,7 +543,7 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.Client, id string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -567,7 +567,7 @@ func waitReplicationTaskSteady(ctx context.Context, conn *dms.Client, id string)
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -1008,7 +1008,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *docdb.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBCluster); ok {
 		return output, err
@@ -1032,7 +1032,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *docdb.Client, id string, ti
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBCluster); ok {
 		return output, err
@@ -410,7 +410,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *docdb.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
 		return output, err
@@ -428,7 +428,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *docdb.Client, id string
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
 		return output, err
@@ -446,7 +446,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *docdb.Client, id string
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
 		return output, err
@@ -409,7 +409,7 @@ func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Cluster); ok {
 		return out, err
 	}
@@ -427,7 +427,7 @@ func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Cluster); ok {
 		return out, err
 	}
@@ -443,7 +443,7 @@ func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Cluster); ok {
 		return out, err
 	}
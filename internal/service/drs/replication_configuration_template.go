@@ -382,7 +382,7 @@ func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *dr
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationConfigurationTemplate); ok {
 		return output, err
@@ -401,7 +401,7 @@ func waitReplicationConfigurationTemplateDeleted(ctx context.Context, conn *drs.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationConfigurationTemplate); ok {
 		return output, err
@@ -178,7 +178,7 @@ func waitSharedDirectoryAccepted(ctx context.Context, conn *directoryservice.Cli
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SharedDirectory); ok {
 		return output, err
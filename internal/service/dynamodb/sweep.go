@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -22,19 +21,18 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
 }
 
-func sweepTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -57,28 +55,24 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			_, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			})
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
-			}
-
 			r := resourceTable()
 			d := r.Data(nil)
 			d.SetId(v)
 			// Refresh replicas.
 			if err := sdk.ReadResource(ctx, r, d, client); err != nil {
 				log.Printf("[WARN] Skipping DynamoDB Table %s: %s", v, err)
+				sweep.SkipResource(ctx)
 				continue
 			}
 			if d.Id() == "" {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, tableSweeper{
+				conn:      conn,
+				name:      v,
+				sweepable: sweep.NewSweepResource(r, d, client),
+			})
 		}
 	}
 
@@ -91,8 +85,36 @@ func sweepTables(region string) error {
 	return nil
 }
 
-func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
+type tableSweeper struct {
+	conn      *dynamodb.Client
+	name      string
+	sweepable sweep.Sweepable
+}
+
+func (ts tableSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	_, err := ts.conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(false),
+		TableName:                 aws.String(ts.name),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] DynamoDB Table (%s): %s", ts.name, err)
+	}
+
+	return ts.sweepable.Delete(ctx, timeout, optFns...)
+}
+
+func (ts tableSweeper) Describe(ctx context.Context) (sweep.Candidate, error) {
+	if v, ok := ts.sweepable.(interface {
+		Describe(context.Context) (sweep.Candidate, error)
+	}); ok {
+		return v.Describe(ctx)
+	}
+
+	return sweep.Candidate{ID: ts.name}, nil
+}
+
+func sweepBackups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -113,12 +135,15 @@ func sweepBackups(region string) error {
 
 			if v.BackupType == awstypes.BackupTypeSystem {
 				log.Printf("[DEBUG] Skipping DynamoDB Backup %s: BackupType=%s", arn, v.BackupType)
+				sweep.SkipResource(ctx)
 				continue
 			}
 
 			sweepResources = append(sweepResources, backupSweeper{
-				conn: conn,
-				arn:  arn,
+				conn:      conn,
+				arn:       arn,
+				createdAt: aws.ToTime(v.BackupCreationDateTime),
+				name:      aws.ToString(v.BackupName),
 			})
 		}
 
@@ -144,8 +169,18 @@ func sweepBackups(region string) error {
 }
 
 type backupSweeper struct {
-	conn *dynamodb.Client
-	arn  string
+	conn      *dynamodb.Client
+	arn       string
+	createdAt time.Time
+	name      string
+}
+
+func (bs backupSweeper) Describe(context.Context) (sweep.Candidate, error) {
+	return sweep.Candidate{
+		CreatedAt: bs.createdAt,
+		ID:        bs.arn,
+		Name:      bs.name,
+	}, nil
 }
 
 func (bs backupSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
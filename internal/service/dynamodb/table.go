@@ -1393,7 +1393,7 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			}
 		}
 
-		err := retry.RetryContext(ctx, max(replicaUpdateTimeout, timeout), func() *retry.RetryError {
+		err := tfresource.Retry(ctx, max(replicaUpdateTimeout, timeout), func() *retry.RetryError {
 			_, err := conn.UpdateTable(ctx, input)
 			if err != nil {
 				if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
@@ -1518,7 +1518,7 @@ func updatePITR(ctx context.Context, conn *dynamodb.Client, tableName string, en
 	optFn := func(o *dynamodb.Options) {
 		o.Region = region
 	}
-	err := retry.RetryContext(ctx, updateTableContinuousBackupsTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, updateTableContinuousBackupsTimeout, func() *retry.RetryError {
 		_, err := conn.UpdateContinuousBackups(ctx, input, optFn)
 		if err != nil {
 			// Backups are still being enabled for this newly created table
@@ -1850,7 +1850,7 @@ func deleteReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 				},
 			}
 
-			err := retry.RetryContext(ctx, updateTableTimeout, func() *retry.RetryError {
+			err := tfresource.Retry(ctx, updateTableTimeout, func() *retry.RetryError {
 				_, err := conn.UpdateTable(ctx, input)
 				notFoundRetries := 0
 				if err != nil {
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -36,7 +37,7 @@ func waitTableActive(ctx context.Context, conn *dynamodb.Client, tableName strin
 		Timeout: max(createTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -53,7 +54,7 @@ func waitTableDeleted(ctx context.Context, conn *dynamodb.Client, tableName stri
 		Timeout: max(deleteTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -70,7 +71,7 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.Client, importARN st
 		Timeout: max(createTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ImportTableDescription); ok {
 		return output, err
@@ -88,7 +89,7 @@ func waitReplicaActive(ctx context.Context, conn *dynamodb.Client, tableName, re
 		Timeout: max(replicaUpdateTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -110,7 +111,7 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.Client, tableName, r
 		Timeout: max(replicaUpdateTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -127,7 +128,7 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.Client, tableName, indexN
 		Timeout: max(updateTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -144,7 +145,7 @@ func waitGSIDeleted(ctx context.Context, conn *dynamodb.Client, tableName, index
 		Timeout: max(updateTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -173,7 +174,7 @@ func waitPITRUpdated(ctx context.Context, conn *dynamodb.Client, tableName strin
 		MinTimeout: 15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -198,7 +199,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 		Refresh: statusTTL(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TimeToLiveDescription); ok {
 		return output, err
@@ -217,7 +218,7 @@ func waitSSEUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -238,7 +239,7 @@ func waitReplicaSSEUpdated(ctx context.Context, conn *dynamodb.Client, region, t
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @ListResource("aws_instance", name="Instance")
+func newInstanceListResource() itypes.ListResourceForSDK {
+	return &instanceListResource{}
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (*instanceListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_instance"
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeInstancesInput{}
+	output, err := findInstances(ctx, conn, &input)
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("listing EC2 Instances", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, v := range output {
+			if v.State != nil && v.State.Name == awstypes.InstanceStateNameTerminated {
+				continue
+			}
+
+			id := aws.ToString(v.InstanceId)
+			d := l.ResourceData()
+			d.SetId(id)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = id
+			if v := keyValueTags(ctx, v.Tags).Map()["Name"]; v != "" {
+				result.DisplayName = v
+			}
+			l.SetResult(ctx, request.IncludeResource, d, &result)
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -1244,7 +1244,7 @@ func waitLaunchTemplateReady(ctx context.Context, conn *ec2.Client, id string, i
 		ContinuousTargetOccurence: 3,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -48,3 +48,14 @@ func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]a
 		},
 	}
 }
+
+// Named concurrency limits.
+const (
+	concurrencyLimitNetworkInterfaceAttach = "ec2.network_interface_attach"
+)
+
+func (p *servicePackage) ConcurrencyLimits(context.Context) []conns.ConcurrencyLimit {
+	return []conns.ConcurrencyLimit{
+		{Name: concurrencyLimitNetworkInterfaceAttach, Limit: 10},
+	}
+}
@@ -16,16 +16,16 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -33,17 +33,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -51,17 +51,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -69,7 +69,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -77,12 +77,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -91,17 +91,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -109,7 +109,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -119,7 +119,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -127,7 +127,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -138,7 +138,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -147,17 +147,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -176,7 +176,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -186,12 +186,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -203,12 +203,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -216,12 +216,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -276,7 +276,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -284,12 +284,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -297,17 +297,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -319,12 +319,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -333,7 +333,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -342,12 +342,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -357,12 +357,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -370,12 +370,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -395,12 +395,12 @@ func RegisterSweepers() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -410,32 +410,32 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_ipam_resource_discovery",
 		F:    sweepIPAMResourceDiscoveries,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -443,7 +443,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -451,7 +451,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -459,12 +459,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
@@ -473,8 +473,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepCapacityReservations(region string) error {
-	ctx := sweep.Context(region)
+func sweepCapacityReservations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -501,6 +500,10 @@ func sweepCapacityReservations(region string) error {
 		if r.State != awstypes.CapacityReservationStateCancelled && r.State != awstypes.CapacityReservationStateExpired {
 			id := aws.ToString(r.CapacityReservationId)
 
+			if sweep.DryRun(ctx, id) {
+				continue
+			}
+
 			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
 
 			opts := &ec2.CancelCapacityReservationInput{
@@ -518,8 +521,7 @@ func sweepCapacityReservations(region string) error {
 	return nil
 }
 
-func sweepCarrierGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCarrierGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -559,8 +561,7 @@ func sweepCarrierGateways(region string) error {
 	return nil
 }
 
-func sweepClientVPNEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -600,8 +601,7 @@ func sweepClientVPNEndpoints(region string) error {
 	return nil
 }
 
-func sweepClientVPNNetworkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNNetworkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -662,8 +662,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -708,8 +707,7 @@ func sweepFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEBSVolumes(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSVolumes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -756,8 +754,7 @@ func sweepEBSVolumes(region string) error {
 	return nil
 }
 
-func sweepEBSSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -799,8 +796,7 @@ func sweepEBSSnapshots(region string) error {
 	return nil
 }
 
-func sweepEgressOnlyInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepEgressOnlyInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -840,8 +836,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 	return nil
 }
 
-func sweepEIPs(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -890,8 +885,7 @@ func sweepEIPs(region string) error {
 	return nil
 }
 
-func sweepEIPDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -931,8 +925,7 @@ func sweepEIPDomainNames(region string) error {
 	return nil
 }
 
-func sweepFlowLogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFlowLogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -972,8 +965,7 @@ func sweepFlowLogs(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+func sweepHosts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1013,8 +1005,7 @@ func sweepHosts(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1067,8 +1058,7 @@ func sweepInstances(region string) error {
 	return nil
 }
 
-func sweepInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1147,8 +1137,7 @@ func sweepInternetGateways(region string) error {
 	return nil
 }
 
-func sweepKeyPairs(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeyPairs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1185,8 +1174,7 @@ func sweepKeyPairs(region string) error {
 	return nil
 }
 
-func sweepLaunchTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepLaunchTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1226,8 +1214,7 @@ func sweepLaunchTemplates(region string) error {
 	return nil
 }
 
-func sweepNATGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepNATGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1267,8 +1254,7 @@ func sweepNATGateways(region string) error {
 	return nil
 }
 
-func sweepNetworkACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1320,8 +1306,7 @@ func sweepNetworkACLs(region string) error {
 	return nil
 }
 
-func sweepNetworkInterfaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInterfaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1368,8 +1353,7 @@ func sweepNetworkInterfaces(region string) error {
 	return nil
 }
 
-func sweepManagedPrefixLists(region string) error {
-	ctx := sweep.Context(region)
+func sweepManagedPrefixLists(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1413,8 +1397,7 @@ func sweepManagedPrefixLists(region string) error {
 	return nil
 }
 
-func sweepNetworkInsightsPaths(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInsightsPaths(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1454,8 +1437,7 @@ func sweepNetworkInsightsPaths(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPlacementGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlacementGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1492,8 +1474,7 @@ func sweepPlacementGroups(region string) error {
 	return nil
 }
 
-func sweepRouteTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1519,6 +1500,11 @@ func sweepRouteTables(region string) error {
 
 		for _, routeTable := range page.RouteTables {
 			id := aws.ToString(routeTable.RouteTableId)
+
+			if sweep.DryRun(ctx, id) {
+				continue
+			}
+
 			isMainRouteTableAssociation := false
 
 			for _, routeTableAssociation := range routeTable.Associations {
@@ -1595,8 +1581,7 @@ func sweepRouteTables(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1605,6 +1590,7 @@ func sweepSecurityGroups(region string) error {
 
 	conn := client.EC2Client(ctx)
 	input := &ec2.DescribeSecurityGroupsInput{}
+	selected := make(map[string]bool)
 
 	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
 	pages := ec2.NewDescribeSecurityGroupsPaginator(conn, input)
@@ -1626,6 +1612,11 @@ func sweepSecurityGroups(region string) error {
 				continue
 			}
 
+			if sweep.DryRun(ctx, aws.ToString(sg.GroupId)) {
+				continue
+			}
+			selected[aws.ToString(sg.GroupId)] = true
+
 			if sg.IpPermissions != nil {
 				req := &ec2.RevokeSecurityGroupIngressInput{
 					GroupId:       sg.GroupId,
@@ -1669,12 +1660,17 @@ func sweepSecurityGroups(region string) error {
 				continue
 			}
 
+			// Only delete Security Groups whose rules were deleted above.
+			if !selected[aws.ToString(sg.GroupId)] {
+				continue
+			}
+
 			input := &ec2.DeleteSecurityGroupInput{
 				GroupId: sg.GroupId,
 			}
 
 			// Handle EC2 eventual consistency
-			err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+			err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 				_, err := conn.DeleteSecurityGroup(ctx, input)
 
 				if tfawserr.ErrCodeEquals(err, "DependencyViolation") {
@@ -1695,8 +1691,7 @@ func sweepSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepSpotFleetRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotFleetRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1744,8 +1739,7 @@ func sweepSpotFleetRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSpotInstanceRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotInstanceRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1823,8 +1817,7 @@ func sweepSubnets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 	return sweepResources, nil
 }
 
-func sweepTrafficMirrorFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1864,8 +1857,7 @@ func sweepTrafficMirrorFilters(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorSessions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorSessions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1905,8 +1897,7 @@ func sweepTrafficMirrorSessions(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1946,8 +1937,7 @@ func sweepTrafficMirrorTargets(region string) error {
 	return nil
 }
 
-func sweepTransitGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1991,8 +1981,7 @@ func sweepTransitGateways(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnectPeers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnectPeers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2036,8 +2025,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnects(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2081,8 +2069,7 @@ func sweepTransitGatewayConnects(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayMulticastDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayMulticastDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2126,8 +2113,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeeringAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeeringAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2171,8 +2157,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2216,8 +2201,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCDHCPOptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCDHCPOptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2286,8 +2270,7 @@ func sweepVPCDHCPOptions(region string) error {
 	return nil
 }
 
-func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2341,8 +2324,7 @@ func sweepVPCEndpoints(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointConnectionAccepters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointConnectionAccepters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2386,8 +2368,7 @@ func sweepVPCEndpointConnectionAccepters(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2436,8 +2417,7 @@ func sweepVPCEndpointServices(region string) error {
 	return nil
 }
 
-func sweepVPCPeeringConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCPeeringConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2479,8 +2459,7 @@ func sweepVPCPeeringConnections(region string) error {
 	return nil
 }
 
-func sweepVPCs(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2527,8 +2506,7 @@ func sweepVPCs(region string) error {
 	return nil
 }
 
-func sweepVPNConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2571,8 +2549,7 @@ func sweepVPNConnections(region string) error {
 	return nil
 }
 
-func sweepVPNGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2621,8 +2598,7 @@ func sweepVPNGateways(region string) error {
 	return nil
 }
 
-func sweepCustomerGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomerGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2663,8 +2639,7 @@ func sweepCustomerGateways(region string) error {
 	return nil
 }
 
-func sweepIPAMs(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPAMs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2707,8 +2682,7 @@ func sweepIPAMs(region string) error {
 	return nil
 }
 
-func sweepIPAMResourceDiscoveries(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPAMResourceDiscoveries(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2753,8 +2727,7 @@ func sweepIPAMResourceDiscoveries(region string) error {
 	return nil
 }
 
-func sweepAMIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepAMIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2798,8 +2771,7 @@ func sweepAMIs(region string) error {
 	return nil
 }
 
-func sweepNetworkPerformanceMetricSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkPerformanceMetricSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2842,8 +2814,7 @@ func sweepNetworkPerformanceMetricSubscriptions(region string) error {
 	return nil
 }
 
-func sweepInstanceConnectEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstanceConnectEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2885,8 +2856,7 @@ func sweepInstanceConnectEndpoints(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2928,8 +2898,7 @@ func sweepVerifiedAccessEndpoints(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2971,8 +2940,7 @@ func sweepVerifiedAccessGroups(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -3014,8 +2982,7 @@ func sweepVerifiedAccessInstances(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessTrustProviders(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessTrustProviders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -3057,8 +3024,7 @@ func sweepVerifiedAccessTrustProviders(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessTrustProviderAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessTrustProviderAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -84,7 +84,7 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 			return output, "ok", err
 		},
 	}
-	_, err := c.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, c)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating VPC Endpoint Subnet Association (%s): %s", id, err)
@@ -55,9 +55,7 @@ func resourceNetworkInterfaceAttachment() *schema.Resource {
 
 func resourceNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
-
-	attachmentID, err := attachNetworkInterface(ctx, conn,
+	attachmentID, err := attachNetworkInterface(ctx, meta.(*conns.AWSClient),
 		d.Get(names.AttrNetworkInterfaceID).(string),
 		d.Get(names.AttrInstanceID).(string),
 		d.Get("device_index").(int),
@@ -326,7 +326,7 @@ func modifyVPCPeeringConnectionOptions(ctx context.Context, conn *ec2.Client, d
 
 	// Retry reading back the modified options to deal with eventual consistency.
 	// Often this is to do with a delay transitioning from pending-acceptance to active.
-	err := retry.RetryContext(ctx, ec2PropagationTimeout, func() *retry.RetryError { // nosemgrep:ci.helper-schema-retry-RetryContext-without-TimeoutError-check
+	err := tfresource.Retry(ctx, ec2PropagationTimeout, func() *retry.RetryError { // nosemgrep:ci.helper-schema-retry-RetryContext-without-TimeoutError-check
 		vpcPeeringConnection, err := findVPCPeeringConnectionByID(ctx, conn, d.Id())
 
 		if err != nil {
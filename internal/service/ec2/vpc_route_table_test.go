@@ -1298,7 +1298,7 @@ func testAccCheckRouteTableWaitForVPCEndpointRoute(ctx context.Context, routeTab
 
 		plId := aws.ToString(resp.PrefixLists[0].PrefixListId)
 
-		err = retry.RetryContext(ctx, 3*time.Minute, func() *retry.RetryError {
+		err = tfresource.Retry(ctx, 3*time.Minute, func() *retry.RetryError {
 			resp, err := conn.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
 				RouteTableIds: []string{aws.ToString(routeTable.RouteTableId)},
 			})
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @ListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource() itypes.ListResourceForSDK {
+	return &securityGroupListResource{}
+}
+
+type securityGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (*securityGroupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_security_group"
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeSecurityGroupsInput{}
+	output, err := findSecurityGroups(ctx, conn, &input)
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("listing EC2 Security Groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, v := range output {
+			// Default security groups are managed by aws_default_security_group.
+			if aws.ToString(v.GroupName) == defaultSecurityGroupName {
+				continue
+			}
+
+			d := l.ResourceData()
+			d.SetId(aws.ToString(v.GroupId))
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = aws.ToString(v.GroupName)
+			l.SetResult(ctx, request.IncludeResource, d, &result)
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
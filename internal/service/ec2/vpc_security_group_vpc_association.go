@@ -221,7 +221,7 @@ func waitSecurityGroupVPCAssociationCreated(ctx context.Context, conn *ec2.Clien
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.SecurityGroupVpcAssociation); ok {
 		return out, err
 	}
@@ -237,7 +237,7 @@ func waitSecurityGroupVPCAssociationDeleted(ctx context.Context, conn *ec2.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.SecurityGroupVpcAssociation); ok {
 		return out, err
 	}
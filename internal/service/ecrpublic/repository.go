@@ -166,7 +166,7 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	var err error
-	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 		out, err = conn.DescribeRepositories(ctx, input)
 		if d.IsNewResource() && errs.IsA[*awstypes.RepositoryNotFoundException](err) {
 			return retry.RetryableError(err)
@@ -265,7 +265,7 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta
 	input := &ecrpublic.DescribeRepositoriesInput{
 		RepositoryNames: []string{d.Id()},
 	}
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err = conn.DescribeRepositories(ctx, input)
 		if err != nil {
 			if errs.IsA[*awstypes.RepositoryNotFoundException](err) {
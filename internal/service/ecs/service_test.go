@@ -1855,7 +1855,7 @@ func testAccCheckServiceExists(ctx context.Context, name string, service *awstyp
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
 
-		err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 			var err error
 			service, err = tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["cluster"])
 
@@ -326,7 +326,7 @@ func waitAccessPointCreated(ctx context.Context, conn *efs.Client, id string) (*
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AccessPointDescription); ok {
 		return output, err
@@ -347,7 +347,7 @@ func waitAccessPointDeleted(ctx context.Context, conn *efs.Client, id string) (*
 		Timeout: accessPointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AccessPointDescription); ok {
 		return output, err
@@ -337,7 +337,7 @@ func waitMountTargetCreated(ctx context.Context, conn *efs.Client, id string, ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.MountTargetDescription); ok {
 		return output, err
@@ -356,7 +356,7 @@ func waitMountTargetDeleted(ctx context.Context, conn *efs.Client, id string, ti
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.MountTargetDescription); ok {
 		return output, err
@@ -524,7 +524,7 @@ func waitAddonCreated(ctx context.Context, conn *eks.Client, clusterName, addonN
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*types.Addon); ok {
 		if status, health := output.Status, output.Health; status == types.AddonStatusCreateFailed && health != nil {
@@ -545,7 +545,7 @@ func waitAddonDeleted(ctx context.Context, conn *eks.Client, clusterName, addonN
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Addon); ok {
 		if status, health := output.Status, output.Health; status == types.AddonStatusDeleteFailed && health != nil {
@@ -566,7 +566,7 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterNam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
@@ -1043,7 +1043,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		return output, err
@@ -1064,7 +1064,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		return output, err
@@ -1081,7 +1081,7 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
@@ -278,7 +278,7 @@ func waitFargateProfileCreated(ctx context.Context, conn *eks.Client, clusterNam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FargateProfile); ok {
 		return output, err
@@ -295,7 +295,7 @@ func waitFargateProfileDeleted(ctx context.Context, conn *eks.Client, clusterNam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FargateProfile); ok {
 		return output, err
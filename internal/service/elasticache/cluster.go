@@ -775,7 +775,7 @@ func deleteCacheCluster(ctx context.Context, conn *elasticache.Client, cacheClus
 	}
 
 	log.Printf("[DEBUG] Deleting ElastiCache Cache Cluster: %s", cacheClusterID)
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteCacheCluster(ctx, input)
 		if err != nil {
 			if errs.IsAErrorMessageContains[*awstypes.InvalidCacheClusterStateFault](err, "serving as primary") {
@@ -898,7 +898,7 @@ func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.Client, ca
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CacheCluster); ok {
 		return output, err
@@ -925,7 +925,7 @@ func waitCacheClusterDeleted(ctx context.Context, conn *elasticache.Client, cach
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CacheCluster); ok {
 		return output, err
@@ -718,7 +718,7 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalReplicationGroup); ok {
 		return output, err
@@ -742,7 +742,7 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.Cl
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalReplicationGroup); ok {
 		return output, err
@@ -803,7 +803,7 @@ func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elastic
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalReplicationGroupMember); ok {
 		return output, err
@@ -361,7 +361,7 @@ func resourceResetParameterGroup(ctx context.Context, conn *elasticache.Client,
 		CacheParameterGroupName: aws.String(name),
 		ParameterNameValues:     tfslices.Values(parameters),
 	}
-	return retry.RetryContext(ctx, 30*time.Second, func() *retry.RetryError {
+	return tfresource.Retry(ctx, 30*time.Second, func() *retry.RetryError {
 		_, err := conn.ResetCacheParameterGroup(ctx, &input)
 		if err != nil {
 			if errs.IsAErrorMessageContains[*awstypes.InvalidCacheParameterGroupStateFault](err, " has pending changes") {
@@ -289,7 +289,7 @@ func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.Client,
 		Delay:          30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -517,7 +517,7 @@ func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache.Client,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServerlessCache); ok {
 		return output, err
@@ -540,7 +540,7 @@ func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache.Client, c
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServerlessCache); ok {
 		return output, err
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +44,10 @@ func resourceUser() *schema.Resource {
 
 		CustomizeDiff: verify.SetTagsDiff,
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("passwords"), cty.GetAttrPath("password_wo")),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -106,7 +111,21 @@ func resourceUser() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
 				},
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(16, 128),
+				ConflictsWith: []string{"passwords"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -147,6 +166,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Passwords = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		input.Passwords = []string{passwordWO}
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -254,6 +284,18 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
 		}
 
+		if d.HasChange("password_wo_version") {
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" {
+				input.Passwords = []string{passwordWO}
+			}
+		}
+
 		_, err := conn.ModifyUser(ctx, input)
 
 		if err != nil {
@@ -370,7 +412,7 @@ func waitUserCreated(ctx context.Context, conn *elasticache.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.User); ok {
 		return output, err
@@ -387,7 +429,7 @@ func waitUserUpdated(ctx context.Context, conn *elasticache.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.User); ok {
 		return output, err
@@ -404,7 +446,7 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.User); ok {
 		return output, err
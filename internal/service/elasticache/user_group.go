@@ -288,7 +288,7 @@ func waitUserGroupCreated(ctx context.Context, conn *elasticache.Client, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.UserGroup); ok {
 		return output, err
@@ -307,7 +307,7 @@ func waitUserGroupUpdated(ctx context.Context, conn *elasticache.Client, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.UserGroup); ok {
 		return output, err
@@ -326,7 +326,7 @@ func waitUserGroupDeleted(ctx context.Context, conn *elasticache.Client, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.UserGroup); ok {
 		return output, err
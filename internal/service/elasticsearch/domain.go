@@ -1135,7 +1135,7 @@ func waitUpgradeSucceeded(ctx context.Context, conn *elasticsearch.Client, name
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticsearch.GetUpgradeStatusOutput); ok {
 		return output, err
@@ -1152,7 +1152,7 @@ func waitDomainCreated(ctx context.Context, conn *elasticsearch.Client, domainNa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ElasticsearchDomainStatus); ok {
 		if v := output.ChangeProgressDetails; v != nil {
@@ -1173,7 +1173,7 @@ func waitDomainConfigUpdated(ctx context.Context, conn *elasticsearch.Client, do
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ElasticsearchDomainStatus); ok {
 		if v := output.ChangeProgressDetails; v != nil {
@@ -1194,7 +1194,7 @@ func waitDomainDeleted(ctx context.Context, conn *elasticsearch.Client, domainNa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ElasticsearchDomainStatus); ok {
 		if v := output.ChangeProgressDetails; v != nil {
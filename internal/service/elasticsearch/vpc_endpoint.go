@@ -298,7 +298,7 @@ func waitVPCEndpointCreated(ctx context.Context, conn *elasticsearchservice.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpoint); ok {
 		return output, err
@@ -315,7 +315,7 @@ func waitVPCEndpointUpdated(ctx context.Context, conn *elasticsearchservice.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpoint); ok {
 		return output, err
@@ -332,7 +332,7 @@ func waitVPCEndpointDeleted(ctx context.Context, conn *elasticsearchservice.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpoint); ok {
 		return output, err
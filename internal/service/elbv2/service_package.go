@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// The `aws_alb*` resource types are aliases of the `aws_lb*` resource types.
+func (p *servicePackage) SDKResourceStateMovers(context.Context) []conns.SDKResourceStateMover {
+	return []conns.SDKResourceStateMover{
+		{SourceTypeName: "aws_alb", TargetTypeName: "aws_lb"},
+		{SourceTypeName: "aws_alb_listener", TargetTypeName: "aws_lb_listener"},
+		{SourceTypeName: "aws_alb_listener_certificate", TargetTypeName: "aws_lb_listener_certificate"},
+		{SourceTypeName: "aws_alb_listener_rule", TargetTypeName: "aws_lb_listener_rule"},
+		{SourceTypeName: "aws_alb_target_group", TargetTypeName: "aws_lb_target_group"},
+		{SourceTypeName: "aws_alb_target_group_attachment", TargetTypeName: "aws_lb_target_group_attachment"},
+	}
+}
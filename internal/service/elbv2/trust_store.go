@@ -325,7 +325,7 @@ func waitTrustStoreActive(ctx context.Context, conn *elasticloadbalancingv2.Clie
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrustStore); ok {
 		return output, err
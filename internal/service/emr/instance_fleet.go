@@ -416,7 +416,7 @@ func waitInstanceFleetRunning(ctx context.Context, conn *emr.Client, clusterID,
 		MinTimeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceFleet); ok {
 		return output, err
@@ -441,7 +441,7 @@ func waitInstanceGroupRunning(ctx context.Context, conn *emr.Client, clusterID,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceGroup); ok {
 		return output, err
@@ -464,7 +464,7 @@ func waitConnectionCreated(ctx context.Context, conn *eventbridge.Client, name s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -486,7 +486,7 @@ func waitConnectionUpdated(ctx context.Context, conn *eventbridge.Client, name s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -508,7 +508,7 @@ func waitConnectionDeleted(ctx context.Context, conn *eventbridge.Client, name s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
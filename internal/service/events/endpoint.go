@@ -351,7 +351,7 @@ func waitEndpointCreated(ctx context.Context, conn *eventbridge.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -370,7 +370,7 @@ func waitEndpointUpdated(ctx context.Context, conn *eventbridge.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -389,7 +389,7 @@ func waitEndpointDeleted(ctx context.Context, conn *eventbridge.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -24,7 +24,7 @@ func waitFeatureCreated(ctx context.Context, conn *evidently.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Feature); ok {
 		return output, err
@@ -41,7 +41,7 @@ func waitFeatureUpdated(ctx context.Context, conn *evidently.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Feature); ok {
 		return output, err
@@ -58,7 +58,7 @@ func waitFeatureDeleted(ctx context.Context, conn *evidently.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Feature); ok {
 		return output, err
@@ -75,7 +75,7 @@ func waitLaunchCreated(ctx context.Context, conn *evidently.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Launch); ok {
 		if v := aws.ToString(output.StatusReason); v != "" {
@@ -96,7 +96,7 @@ func waitLaunchUpdated(ctx context.Context, conn *evidently.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Launch); ok {
 		if v := aws.ToString(output.StatusReason); v != "" {
@@ -117,7 +117,7 @@ func waitLaunchDeleted(ctx context.Context, conn *evidently.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Launch); ok {
 		if v := aws.ToString(output.StatusReason); v != "" {
@@ -138,7 +138,7 @@ func waitProjectCreated(ctx context.Context, conn *evidently.Client, nameOrARN s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Project); ok {
 		return output, err
@@ -155,7 +155,7 @@ func waitProjectUpdated(ctx context.Context, conn *evidently.Client, nameOrARN s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Project); ok {
 		return output, err
@@ -172,7 +172,7 @@ func waitProjectDeleted(ctx context.Context, conn *evidently.Client, nameOrARN s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Project); ok {
 		return output, err
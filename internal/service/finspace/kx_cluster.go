@@ -722,7 +722,7 @@ func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
 		return out, err
 	}
@@ -740,7 +740,7 @@ func waitKxClusterUpdated(ctx context.Context, conn *finspace.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
 		return out, err
 	}
@@ -756,7 +756,7 @@ func waitKxClusterDeleted(ctx context.Context, conn *finspace.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
 		return out, err
 	}
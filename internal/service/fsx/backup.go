@@ -259,7 +259,7 @@ func waitBackupAvailable(ctx context.Context, conn *fsx.Client, id string) (*aws
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Backup); ok {
 		return output, err
@@ -279,7 +279,7 @@ func waitBackupDeleted(ctx context.Context, conn *fsx.Client, id string) (*awsty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Backup); ok {
 		return output, err
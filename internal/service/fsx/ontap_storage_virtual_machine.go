@@ -441,7 +441,7 @@ func waitStorageVirtualMachineCreated(ctx context.Context, conn *fsx.Client, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -463,7 +463,7 @@ func waitStorageVirtualMachineUpdated(ctx context.Context, conn *fsx.Client, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -486,7 +486,7 @@ func waitStorageVirtualMachineDeleted(ctx context.Context, conn *fsx.Client, id
 		PollInterval: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -257,7 +257,7 @@ func waitBuildReady(ctx context.Context, conn *gamelift.Client, id string) (*aws
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Build); ok {
 		return output, err
@@ -409,7 +409,7 @@ func waitAcceleratorDeployed(ctx context.Context, conn *globalaccelerator.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Accelerator); ok {
 		return output, err
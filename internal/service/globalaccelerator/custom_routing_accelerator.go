@@ -402,7 +402,7 @@ func waitCustomRoutingAcceleratorDeployed(ctx context.Context, conn *globalaccel
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CustomRoutingAccelerator); ok {
 		return output, err
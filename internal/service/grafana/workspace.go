@@ -506,7 +506,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *grafana.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WorkspaceDescription); ok {
 		return output, err
@@ -523,7 +523,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *grafana.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WorkspaceDescription); ok {
 		return output, err
@@ -540,7 +540,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *grafana.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WorkspaceDescription); ok {
 		return output, err
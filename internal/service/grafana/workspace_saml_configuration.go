@@ -327,7 +327,7 @@ func waitWorkspaceSAMLConfigurationCreated(ctx context.Context, conn *grafana.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SamlAuthentication); ok {
 		return output, err
@@ -253,7 +253,7 @@ func inviteMemberWaiter(ctx context.Context, accountID, detectorID string, timeo
 
 	// wait until e-mail verification finishes
 	var out *guardduty.GetMembersOutput
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		log.Printf("[DEBUG] Reading GuardDuty Member: %+v", input)
 		var err error
 		out, err = conn.GetMembers(ctx, &input)
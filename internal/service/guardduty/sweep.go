@@ -4,32 +4,32 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
 }
 
-func sweepDetectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepDetectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -55,6 +55,10 @@ func sweepDetectors(region string) error {
 		}
 
 		for _, detectorID := range page.DetectorIds {
+			if sweep.DryRun(ctx, detectorID) {
+				continue
+			}
+
 			input := &guardduty.DeleteDetectorInput{
 				DetectorId: &detectorID,
 			}
@@ -76,8 +80,7 @@ func sweepDetectors(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPublishingDestinations(region string) error {
-	ctx := sweep.Context(region)
+func sweepPublishingDestinations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -120,6 +123,10 @@ func sweepPublishingDestinations(region string) error {
 				}
 
 				for _, destination_element := range page.Destinations {
+					if sweep.DryRun(ctx, aws.ToString(destination_element.DestinationId)) {
+						continue
+					}
+
 					input := &guardduty.DeletePublishingDestinationInput{
 						DestinationId: destination_element.DestinationId,
 						DetectorId:    &detectorID,
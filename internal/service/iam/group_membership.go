@@ -80,7 +80,7 @@ func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, me
 
 	var ul []string
 
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		pages := iam.NewGetGroupPaginator(conn, input)
 		for pages.HasMorePages() {
 			page, err := pages.NextPage(ctx)
@@ -380,7 +380,7 @@ func waitInstanceProfileReady(ctx context.Context, conn *iam.Client, id string,
 		ContinuousTargetOccurence: 3,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_iam_role", name="Role")
+func newRoleListResource() itypes.ListResourceForSDK {
+	return &roleListResource{}
+}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (*roleListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role"
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().IAMClient(ctx)
+
+	input := iam.ListRolesInput{}
+	output, err := findRoles(ctx, conn, &input)
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("listing IAM Roles", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, v := range output {
+			name := aws.ToString(v.RoleName)
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set(names.AttrName, name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+			l.SetResult(ctx, request.IncludeResource, d, &result)
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
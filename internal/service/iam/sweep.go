@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -29,7 +28,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -43,7 +42,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -55,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -92,14 +91,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -112,8 +111,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -143,6 +141,10 @@ func sweepGroups(region string) error {
 				continue
 			}
 
+			if sweep.DryRun(ctx, name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting IAM Group: %s", name)
 
 			getGroupInput := &iam.GetGroupInput{
@@ -346,8 +348,7 @@ func sweepServiceSpecificCredentials(ctx context.Context, client *conns.AWSClien
 	return sweepResources, nil
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -420,8 +421,7 @@ func (ps policySweeper) Delete(ctx context.Context, timeout time.Duration, optFn
 	return nil
 }
 
-func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -429,25 +429,22 @@ func sweepRoles(region string) error {
 	conn := client.IAMClient(ctx)
 
 	roles := make([]string, 0)
-	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-			return nil
-		}
+	output, err := findRoles(ctx, conn, &iam.ListRolesInput{})
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
+		return nil
+	}
 
-		if err != nil {
-			return fmt.Errorf("retrieving IAM Roles: %w", err)
-		}
+	if err != nil {
+		return fmt.Errorf("retrieving IAM Roles: %w", err)
+	}
 
-		for _, role := range page.Roles {
-			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
-				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
-			}
+	for _, role := range output {
+		roleName := aws.ToString(role.RoleName)
+		if roleNameFilter(roleName) {
+			roles = append(roles, roleName)
+		} else {
+			log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
 		}
 	}
 
@@ -501,8 +498,7 @@ func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 	return sweepResources, err
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepServerCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -522,6 +518,10 @@ func sweepServerCertificates(region string) error {
 		}
 
 		for _, sc := range page.ServerCertificateMetadataList {
+			if sweep.DryRun(ctx, aws.ToString(sc.ServerCertificateName)) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
 
 			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
@@ -580,8 +580,7 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 	return sweepResources, nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
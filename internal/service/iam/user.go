@@ -436,7 +436,7 @@ func deleteUserLoginProfile(ctx context.Context, conn *iam.Client, username stri
 	input := &iam.DeleteLoginProfileInput{
 		UserName: aws.String(username),
 	}
-	err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		_, err = conn.DeleteLoginProfile(ctx, input)
 		if err != nil {
 			var errNoSuchEntityException *awstypes.NoSuchEntityException
@@ -44,7 +44,7 @@ func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string,
 		Delay:                     10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Role); ok {
 		return output, err
@@ -488,7 +488,7 @@ func waitImageStatusAvailable(ctx context.Context, conn *imagebuilder.Client, ar
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Image); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.State.Reason)))
@@ -220,7 +220,7 @@ func waitDelegatedAdminAccountEnabled(ctx context.Context, conn *inspector2.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DelegatedAdminAccount); ok {
 		return output, err
@@ -237,7 +237,7 @@ func waitDelegatedAdminAccountDisabled(ctx context.Context, conn *inspector2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DelegatedAdminAccount); ok {
 		return output, err
@@ -199,7 +199,7 @@ func waitMemberAssociationCreated(ctx context.Context, conn *inspector2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Member); ok {
 		return output, err
@@ -216,7 +216,7 @@ func waitMemberAssociationDeleted(ctx context.Context, conn *inspector2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Member); ok {
 		return output, err
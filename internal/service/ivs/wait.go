@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ivs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.Client, id string, timeout time.Duration) (*awstypes.PlaybackKeyPair, error) {
@@ -23,7 +24,7 @@ func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.Client, id string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.PlaybackKeyPair); ok {
 		return out, err
 	}
@@ -39,7 +40,7 @@ func waitPlaybackKeyPairDeleted(ctx context.Context, conn *ivs.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.PlaybackKeyPair); ok {
 		return out, err
 	}
@@ -57,7 +58,7 @@ func waitRecordingConfigurationCreated(ctx context.Context, conn *ivs.Client, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.RecordingConfiguration); ok {
 		return out, err
 	}
@@ -73,7 +74,7 @@ func waitRecordingConfigurationDeleted(ctx context.Context, conn *ivs.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.RecordingConfiguration); ok {
 		return out, err
 	}
@@ -91,7 +92,7 @@ func waitChannelCreated(ctx context.Context, conn *ivs.Client, id string, timeou
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Channel); ok {
 		return out, err
 	}
@@ -109,7 +110,7 @@ func waitChannelUpdated(ctx context.Context, conn *ivs.Client, id string, timeou
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Channel); ok {
 		return out, err
 	}
@@ -125,7 +126,7 @@ func waitChannelDeleted(ctx context.Context, conn *ivs.Client, id string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Channel); ok {
 		return out, err
 	}
@@ -405,7 +405,7 @@ func waitReplicatorCreated(ctx context.Context, conn *kafka.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
 		if stateInfo := output.StateInfo; stateInfo != nil {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(stateInfo.Code), aws.ToString(stateInfo.Message)))
@@ -425,7 +425,7 @@ func waitReplicatorUpdated(ctx context.Context, conn *kafka.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
 		if stateInfo := output.StateInfo; stateInfo != nil {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(stateInfo.Code), aws.ToString(stateInfo.Message)))
@@ -445,7 +445,7 @@ func waitReplicatorDeleted(ctx context.Context, conn *kafka.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
 		if stateInfo := output.StateInfo; stateInfo != nil {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(stateInfo.Code), aws.ToString(stateInfo.Message)))
@@ -268,7 +268,7 @@ func waitCustomPluginCreated(ctx context.Context, conn *kafkaconnect.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafkaconnect.DescribeCustomPluginOutput); ok {
 		if state, stateDescription := output.CustomPluginState, output.StateDescription; state == awstypes.CustomPluginStateCreateFailed && stateDescription != nil {
@@ -289,7 +289,7 @@ func waitCustomPluginDeleted(ctx context.Context, conn *kafkaconnect.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafkaconnect.DescribeCustomPluginOutput); ok {
 		return output, err
@@ -4,23 +4,23 @@
 package kafkaconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_mskconnect_connector",
 		F:    sweepConnectors,
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_mskconnect_custom_plugin",
 		F:    sweepCustomPlugins,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_worker_configuration", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_mskconnect_worker_configuration",
 		F:    sweepWorkerConfigurations,
 		Dependencies: []string{
@@ -37,8 +37,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepConnectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -78,8 +77,7 @@ func sweepConnectors(region string) error {
 	return nil
 }
 
-func sweepCustomPlugins(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomPlugins(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -119,8 +117,7 @@ func sweepCustomPlugins(region string) error {
 	return nil
 }
 
-func sweepWorkerConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkerConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -667,7 +667,7 @@ func waitIndexCreated(ctx context.Context, conn *kendra.Client, id string, timeo
 		Refresh: statusIndex(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
 		if output.Status == types.IndexStatusFailed {
@@ -687,7 +687,7 @@ func waitIndexUpdated(ctx context.Context, conn *kendra.Client, id string, timeo
 		Refresh: statusIndex(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
 		if output.Status == types.IndexStatusFailed {
@@ -707,7 +707,7 @@ func waitIndexDeleted(ctx context.Context, conn *kendra.Client, id string, timeo
 		Refresh: statusIndex(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
@@ -717,7 +717,7 @@ func waitTableCreated(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*keyspaces.GetTableOutput); ok {
 		return output, err
@@ -734,7 +734,7 @@ func waitTableDeleted(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*keyspaces.GetTableOutput); ok {
 		return output, err
@@ -752,7 +752,7 @@ func waitTableUpdated(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*keyspaces.GetTableOutput); ok {
 		return output, err
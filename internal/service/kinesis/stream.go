@@ -590,7 +590,7 @@ func waitStreamCreated(ctx context.Context, conn *kinesis.Client, name string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StreamDescriptionSummary); ok {
 		return output, err
@@ -609,7 +609,7 @@ func waitStreamDeleted(ctx context.Context, conn *kinesis.Client, name string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StreamDescriptionSummary); ok {
 		return output, err
@@ -628,7 +628,7 @@ func waitStreamUpdated(ctx context.Context, conn *kinesis.Client, name string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StreamDescriptionSummary); ok {
 		return output, err
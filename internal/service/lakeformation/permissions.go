@@ -470,7 +470,7 @@ func resourcePermissionsCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	var output *lakeformation.GrantPermissionsOutput
-	err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, IAMPropagationTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.GrantPermissions(ctx, input)
 		if err != nil {
@@ -791,7 +791,7 @@ func resourcePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diags
 	}
 
-	err := retry.RetryContext(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
 		var err error
 		_, err = conn.RevokePermissions(ctx, input)
 		if err != nil {
@@ -838,7 +838,7 @@ func resourcePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta
 	// You can't just wait until permissions = 0 because there could be many other unrelated permissions
 	// on the resource and filtering is non-trivial for table with columns.
 
-	err = retry.RetryContext(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
 		var err error
 		_, err = conn.RevokePermissions(ctx, input)
 
@@ -1137,7 +1137,7 @@ func permissionCountForResource(ctx context.Context, conn *lakeformation.Client,
 	log.Printf("[DEBUG] Reading Lake Formation permissions: %v", input)
 	var allPermissions []awstypes.PrincipalResourcePermissions
 
-	err := retry.RetryContext(ctx, tflakeformation.IAMPropagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, tflakeformation.IAMPropagationTimeout, func() *retry.RetryError {
 		pages := lakeformation.NewListPermissionsPaginator(conn, input)
 
 		for pages.HasMorePages() {
@@ -936,7 +936,7 @@ func waitEventSourceMappingCreated(ctx context.Context, conn *lambda.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetEventSourceMappingOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
@@ -958,7 +958,7 @@ func waitEventSourceMappingUpdated(ctx context.Context, conn *lambda.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetEventSourceMappingOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
@@ -980,7 +980,7 @@ func waitEventSourceMappingDeleted(ctx context.Context, conn *lambda.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetEventSourceMappingOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
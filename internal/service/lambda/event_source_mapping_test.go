@@ -1439,7 +1439,7 @@ func testAccCheckEventSourceMappingIsBeingDisabled(ctx context.Context, v *lambd
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
 		// Disable enabled state
-		err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, 10*time.Minute, func() *retry.RetryError {
 			input := &lambda.UpdateEventSourceMappingInput{
 				Enabled: aws.Bool(false),
 				UUID:    v.UUID,
@@ -1465,7 +1465,7 @@ func testAccCheckEventSourceMappingIsBeingDisabled(ctx context.Context, v *lambd
 		}
 
 		// wait for state to be propagated
-		return retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		return tfresource.Retry(ctx, 10*time.Minute, func() *retry.RetryError {
 			output, err := tflambda.FindEventSourceMappingByID(ctx, conn, aws.ToString(v.UUID))
 
 			if err != nil {
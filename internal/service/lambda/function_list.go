@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @ListResource("aws_lambda_function", name="Function")
+func newFunctionListResource() itypes.ListResourceForSDK {
+	return &functionListResource{}
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (*functionListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_function"
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().LambdaClient(ctx)
+
+	input := lambda.ListFunctionsInput{}
+	output, err := findFunctions(ctx, conn, &input)
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("listing Lambda Functions", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, v := range output {
+			name := aws.ToString(v.FunctionName)
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set("function_name", name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+			l.SetResult(ctx, request.IncludeResource, d, &result)
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -4,25 +4,25 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 		Dependencies: []string{
@@ -31,8 +31,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
+func sweepFunctions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -41,27 +40,24 @@ func sweepFunctions(region string) error {
 	input := &lambda.ListFunctionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	pages := lambda.NewListFunctionsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	functions, err := findFunctions(ctx, conn, input)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
-			return nil
-		}
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
+		return nil
+	}
 
-		if err != nil {
-			return fmt.Errorf("error listing Lambda Functions (%s): %w", region, err)
-		}
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions (%s): %w", region, err)
+	}
 
-		for _, v := range page.Functions {
-			r := resourceFunction()
-			d := r.Data(nil)
-			d.SetId(aws.ToString(v.FunctionName))
-			d.Set("function_name", v.FunctionName)
+	for _, v := range functions {
+		r := resourceFunction()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.FunctionName))
+		d.Set("function_name", v.FunctionName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
@@ -73,8 +69,7 @@ func sweepFunctions(region string) error {
 	return nil
 }
 
-func sweepLayerVersions(region string) error {
-	ctx := sweep.Context(region)
+func sweepLayerVersions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
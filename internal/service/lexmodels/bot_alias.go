@@ -149,7 +149,7 @@ func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		output, err := conn.PutBotAlias(ctx, input)
 
 		if output != nil {
@@ -245,7 +245,7 @@ func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, err := conn.PutBotAlias(ctx, input)
 
 		// IAM eventual consistency
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tflexmodels "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -630,7 +631,7 @@ func TestAccLexModelsIntent_updateWithExternalChange(t *testing.T) {
 					Type: awstypes.FulfillmentActivityType("ReturnIntent"),
 				},
 			}
-			err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+			err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 				_, err := conn.PutIntent(ctx, input)
 
 				if errs.IsA[*awstypes.ConflictException](err) {
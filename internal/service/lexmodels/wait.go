@@ -33,7 +33,7 @@ func waitBotVersionCreated(ctx context.Context, conn *lexmodelbuildingservice.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if output, ok := outputRaw.(*lexmodelbuildingservice.GetBotOutput); ok {
 		if output.Status == awstypes.StatusFailed {
@@ -58,7 +58,7 @@ func waitBotDeleted(ctx context.Context, conn *lexmodelbuildingservice.Client, n
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if output, ok := outputRaw.(*lexmodelbuildingservice.GetBotOutput); ok {
 		if output.Status == awstypes.StatusFailed {
@@ -78,7 +78,7 @@ func waitBotAliasDeleted(ctx context.Context, conn *lexmodelbuildingservice.Clie
 		Refresh: statusBotAlias(ctx, conn, botAliasName, botName),
 		Timeout: botAliasDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetBotAliasOutput); ok {
 		return v, err
@@ -94,7 +94,7 @@ func waitIntentDeleted(ctx context.Context, conn *lexmodelbuildingservice.Client
 		Refresh: statusIntent(ctx, conn, intentId),
 		Timeout: intentDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetIntentVersionsOutput); ok {
 		return v, err
@@ -110,7 +110,7 @@ func waitSlotTypeDeleted(ctx context.Context, conn *lexmodelbuildingservice.Clie
 		Refresh: statusSlotType(ctx, conn, name, SlotTypeVersionLatest),
 		Timeout: slotTypeDeleteTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetSlotTypeOutput); ok {
 		return v, err
@@ -256,7 +256,7 @@ func waitBotVersionCreated(ctx context.Context, conn *lexmodelsv2.Client, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
 		return out, err
 	}
@@ -272,7 +272,7 @@ func waitBotVersionDeleted(ctx context.Context, conn *lexmodelsv2.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
 		return out, err
 	}
@@ -31,6 +31,8 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @IdentityAttribute("region", optional=true)
 // @Testing(tagsTest=false)
 func newDeliverySourceResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliverySourceResource{}
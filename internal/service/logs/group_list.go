@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_cloudwatch_log_group", name="Log Group")
+func newGroupListResource() itypes.ListResourceForSDK {
+	return &groupListResource{}
+}
+
+type groupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (*groupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_group"
+}
+
+func (l *groupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().LogsClient(ctx)
+
+	input := cloudwatchlogs.DescribeLogGroupsInput{}
+	output, err := findLogGroups(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.LogGroup]())
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("listing CloudWatch Logs Log Groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, v := range output {
+			name := aws.ToString(v.LogGroupName)
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set(names.AttrName, name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+			l.SetResult(ctx, request.IncludeResource, d, &result)
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
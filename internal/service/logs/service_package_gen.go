@@ -56,6 +56,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name: names.AttrName,
+					},
+					{
+						Name:     names.AttrRegion,
+						Optional: true,
+					},
+				},
+			},
 		},
 		{
 			Factory:  newIndexPolicyResource,
@@ -65,6 +76,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			Factory:  newGroupListResource,
+			TypeName: "aws_cloudwatch_log_group",
+			Name:     "Log Group",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -120,6 +141,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []types.IdentityAttribute{
+					{
+						Name: names.AttrName,
+					},
+					{
+						Name:     names.AttrRegion,
+						Optional: true,
+					},
+				},
+			},
 		},
 		{
 			Factory:  resourceMetricFilter,
@@ -394,7 +394,7 @@ func waitDeploymentCreated(ctx context.Context, conn *m2.Client, applicationID,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetDeploymentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -413,7 +413,7 @@ func waitDeploymentUpdated(ctx context.Context, conn *m2.Client, applicationID,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetDeploymentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
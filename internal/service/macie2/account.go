@@ -81,7 +81,7 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Status = awstypes.MacieStatus(v.(string))
 	}
 
-	err := retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.EnableMacie(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, string(awstypes.ErrorCodeClientError)) {
@@ -166,7 +166,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	input := &macie2.DisableMacieInput{}
 
-	err := retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.DisableMacie(ctx, input)
 
 		if errs.IsAErrorMessageContains[*awstypes.ConflictException](err, "Cannot disable Macie while associated with an administrator account") {
@@ -61,7 +61,7 @@ func resourceInvitationAccepterCreate(ctx context.Context, d *schema.ResourceDat
 
 	var invitationID string
 	var err error
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		invitationID, err = findInvitationByAccount(ctx, conn, adminAccountID)
 
 		if err != nil {
@@ -116,7 +116,7 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	var err error
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.CreateMember(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, string(awstypes.ErrorCodeClientError)) {
@@ -160,7 +160,7 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	log.Printf("[INFO] Inviting Macie2 Member: %+v", inputInvite)
 
 	var output *macie2.CreateInvitationsOutput
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		output, err = conn.CreateInvitations(ctx, inputInvite)
 
 		if tfawserr.ErrCodeEquals(err, string(awstypes.ErrorCodeClientError)) {
@@ -266,7 +266,7 @@ func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			log.Printf("[INFO] Inviting Macie2 Member: %+v", inputInvite)
 			var output *macie2.CreateInvitationsOutput
 			var err error
-			err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+			err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 				output, err = conn.CreateInvitations(ctx, inputInvite)
 
 				if tfawserr.ErrCodeEquals(err, string(awstypes.ErrorCodeClientError)) {
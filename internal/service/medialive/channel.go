@@ -1040,7 +1040,7 @@ func waitChannelCreated(ctx context.Context, conn *medialive.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1058,7 +1058,7 @@ func waitChannelUpdated(ctx context.Context, conn *medialive.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1074,7 +1074,7 @@ func waitChannelDeleted(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1090,7 +1090,7 @@ func waitChannelStarted(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1106,7 +1106,7 @@ func waitChannelStopped(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
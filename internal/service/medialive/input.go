@@ -389,7 +389,7 @@ func waitInputCreated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -408,7 +408,7 @@ func waitInputUpdated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -424,7 +424,7 @@ func waitInputDeleted(ctx context.Context, conn *medialive.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -284,7 +284,7 @@ func waitMultiplexCreated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -303,7 +303,7 @@ func waitMultiplexUpdated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -319,7 +319,7 @@ func waitMultiplexDeleted(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -335,7 +335,7 @@ func waitMultiplexRunning(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -351,7 +351,7 @@ func waitMultiplexStopped(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
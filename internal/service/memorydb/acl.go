@@ -287,7 +287,7 @@ func waitACLActive(ctx context.Context, conn *memorydb.Client, name string) (*aw
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ACL); ok {
 		return output, err
@@ -307,7 +307,7 @@ func waitACLDeleted(ctx context.Context, conn *memorydb.Client, name string) (*a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ACL); ok {
 		return output, err
@@ -713,7 +713,7 @@ func waitClusterAvailable(ctx context.Context, conn *memorydb.Client, name strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
@@ -730,7 +730,7 @@ func waitClusterDeleted(ctx context.Context, conn *memorydb.Client, name string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
@@ -750,7 +750,7 @@ func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
@@ -770,7 +770,7 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
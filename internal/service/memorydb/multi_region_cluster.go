@@ -523,7 +523,7 @@ func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client,
 		Timeout:                   timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.MultiRegionCluster); ok {
 		return output, err
@@ -542,7 +542,7 @@ func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.Client, n
 		Timeout:                   timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.MultiRegionCluster); ok {
 		return output, err
@@ -309,7 +309,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *memorydb.Client, name stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		return output, err
@@ -326,7 +326,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *memorydb.Client, name string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		return output, err
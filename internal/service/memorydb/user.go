@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,8 +66,22 @@ func resourceUser() *schema.Resource {
 								Type:         schema.TypeString,
 								ValidateFunc: validation.StringLenBetween(16, 128),
 							},
-							Set:       schema.HashString,
-							Sensitive: true,
+							Set:           schema.HashString,
+							Sensitive:     true,
+							ConflictsWith: []string{"authentication_mode.0.password_wo"},
+						},
+						"password_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringLenBetween(16, 128),
+							ConflictsWith: []string{"authentication_mode.0.passwords"},
+						},
+						"password_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"authentication_mode.0.password_wo"},
 						},
 						"password_count": {
 							Type:     schema.TypeInt,
@@ -109,6 +124,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input.AuthenticationMode.Passwords = []string{passwordWO}
+		}
 	}
 
 	_, err := conn.CreateUser(ctx, input)
@@ -142,9 +168,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set(names.AttrARN, user.ARN)
 	if v := user.Authentication; v != nil {
 		tfMap := map[string]interface{}{
-			"passwords":      d.Get("authentication_mode.0.passwords"),
-			"password_count": aws.ToInt32(v.PasswordCount),
-			names.AttrType:   v.Type,
+			"passwords":           d.Get("authentication_mode.0.passwords"),
+			"password_wo_version": d.Get("authentication_mode.0.password_wo_version"),
+			"password_count":      aws.ToInt32(v.PasswordCount),
+			names.AttrType:        v.Type,
 		}
 
 		if err := d.Set("authentication_mode", []interface{}{tfMap}); err != nil {
@@ -172,6 +199,17 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+
+			// get write-only value from configuration
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" {
+				input.AuthenticationMode.Passwords = []string{passwordWO}
+			}
 		}
 
 		_, err := conn.UpdateUser(ctx, input)
@@ -281,7 +319,7 @@ func waitUserActive(ctx context.Context, conn *memorydb.Client, userName string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.User); ok {
 		return output, err
@@ -301,7 +339,7 @@ func waitUserDeleted(ctx context.Context, conn *memorydb.Client, userName string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.User); ok {
 		return output, err
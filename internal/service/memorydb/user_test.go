@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfmemorydb "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
@@ -55,6 +57,42 @@ func TestAccMemoryDBUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccMemoryDBUser_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.MemoryDBServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_passwordWriteOnly(rName, "aaaaaaaaaaaaaaaa", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.passwords.#", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "authentication_mode.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccUserConfig_passwordWriteOnly(rName, "bbbbbbbbbbbbbbbb", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMemoryDBUser_authenticationModeIAM(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := "tf-test-" + sdkacctest.RandString(8)
@@ -311,6 +349,21 @@ resource "aws_memorydb_user" "test" {
 `, rName)
 }
 
+func testAccUserConfig_passwordWriteOnly(rName, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  access_string = "on ~* &* +@all"
+  user_name     = %[1]q
+
+  authentication_mode {
+    type                = "password"
+    password_wo         = %[2]q
+    password_wo_version = %[3]d
+  }
+}
+`, rName, password, passwordVersion)
+}
+
 func testAccUserConfig_authenticationModeIAM(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_memorydb_user" "test" {
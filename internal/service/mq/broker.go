@@ -740,7 +740,7 @@ func waitBrokerCreated(ctx context.Context, conn *mq.Client, id string, timeout
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
 		return output, err
@@ -761,7 +761,7 @@ func waitBrokerDeleted(ctx context.Context, conn *mq.Client, id string, timeout
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
 		return output, err
@@ -777,7 +777,7 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 		Timeout: timeout,
 		Refresh: statusBrokerState(ctx, conn, id),
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
 		return output, err
@@ -949,7 +949,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *neptune.Client, id string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBCluster); ok {
 		return output, err
@@ -973,7 +973,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *neptune.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBCluster); ok {
 		return output, err
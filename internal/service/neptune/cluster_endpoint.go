@@ -315,7 +315,7 @@ func waitClusterEndpointAvailable(ctx context.Context, conn *neptune.Client, clu
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBClusterEndpoint); ok {
 		return output, err
@@ -335,7 +335,7 @@ func waitClusterEndpointDeleted(ctx context.Context, conn *neptune.Client, clust
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBClusterEndpoint); ok {
 		return output, err
@@ -499,7 +499,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *neptune.Client, id strin
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBInstance); ok {
 		return output, err
@@ -521,7 +521,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *neptune.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBInstance); ok {
 		return output, err
@@ -264,7 +264,7 @@ func waitClusterSnapshotCreated(ctx context.Context, conn *neptune.Client, id st
 		Delay:      5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBClusterSnapshot); ok {
 		return output, err
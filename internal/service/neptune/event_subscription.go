@@ -358,7 +358,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *neptune.Client, nam
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -377,7 +377,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *neptune.Client, nam
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -396,7 +396,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *neptune.Client, nam
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -399,7 +399,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *neptune.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
 		return output, err
@@ -417,7 +417,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Client, id stri
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
 		return output, err
@@ -435,7 +435,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Client, id stri
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
 		return output, err
@@ -403,7 +403,7 @@ func waitFirewallPolicyDeleted(ctx context.Context, conn *networkfirewall.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallPolicyOutput); ok {
 		return output, err
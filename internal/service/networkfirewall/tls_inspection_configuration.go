@@ -548,7 +548,7 @@ func waitTLSInspectionConfigurationCreated(ctx context.Context, conn *networkfir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
 		return output, err
@@ -565,7 +565,7 @@ func waitTLSInspectionConfigurationUpdated(ctx context.Context, conn *networkfir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
 		return output, err
@@ -582,7 +582,7 @@ func waitTLSInspectionConfigurationDeleted(ctx context.Context, conn *networkfir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
 		return output, err
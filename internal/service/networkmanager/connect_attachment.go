@@ -329,7 +329,7 @@ func waitConnectAttachmentCreated(ctx context.Context, conn *networkmanager.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -349,7 +349,7 @@ func waitConnectAttachmentDeleted(ctx context.Context, conn *networkmanager.Clie
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -368,7 +368,7 @@ func waitConnectAttachmentAvailable(ctx context.Context, conn *networkmanager.Cl
 		Refresh: statusConnectAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
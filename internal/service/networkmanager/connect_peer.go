@@ -443,7 +443,7 @@ func waitConnectPeerCreated(ctx context.Context, conn *networkmanager.Client, id
 		Refresh: statusConnectPeerState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectPeer); ok {
 		return output, err
@@ -461,7 +461,7 @@ func waitConnectPeerDeleted(ctx context.Context, conn *networkmanager.Client, id
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateconf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateconf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectPeer); ok {
 		return output, err
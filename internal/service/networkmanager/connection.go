@@ -325,7 +325,7 @@ func waitConnectionCreated(ctx context.Context, conn *networkmanager.Client, glo
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Connection); ok {
 		return output, err
@@ -342,7 +342,7 @@ func waitConnectionDeleted(ctx context.Context, conn *networkmanager.Client, glo
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Connection); ok {
 		return output, err
@@ -359,7 +359,7 @@ func waitConnectionUpdated(ctx context.Context, conn *networkmanager.Client, glo
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Connection); ok {
 		return output, err
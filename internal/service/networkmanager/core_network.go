@@ -418,7 +418,7 @@ func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.Client, id
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CoreNetwork); ok {
 		return output, err
@@ -435,7 +435,7 @@ func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.Client, id
 		Refresh: statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CoreNetwork); ok {
 		return output, err
@@ -454,7 +454,7 @@ func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.Client, id
 		Refresh:    statusCoreNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CoreNetwork); ok {
 		return output, err
@@ -585,7 +585,7 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Clie
 		Refresh: statusCoreNetworkPolicyState(ctx, conn, coreNetworkId, policyVersionId),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CoreNetworkPolicy); ok {
 		return output, err
@@ -293,7 +293,7 @@ func waitCustomerGatewayAssociationCreated(ctx context.Context, conn *networkman
 		Refresh: statusCustomerGatewayAssociationState(ctx, conn, globalNetworkID, customerGatewayARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CustomerGatewayAssociation); ok {
 		return output, err
@@ -310,7 +310,7 @@ func waitCustomerGatewayAssociationDeleted(ctx context.Context, conn *networkman
 		Refresh: statusCustomerGatewayAssociationState(ctx, conn, globalNetworkID, customerGatewayARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CustomerGatewayAssociation); ok {
 		return output, err
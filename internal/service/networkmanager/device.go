@@ -419,7 +419,7 @@ func waitDeviceCreated(ctx context.Context, conn *networkmanager.Client, globalN
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Device); ok {
 		return output, err
@@ -436,7 +436,7 @@ func waitDeviceDeleted(ctx context.Context, conn *networkmanager.Client, globalN
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Device); ok {
 		return output, err
@@ -453,7 +453,7 @@ func waitDeviceUpdated(ctx context.Context, conn *networkmanager.Client, globalN
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Device); ok {
 		return output, err
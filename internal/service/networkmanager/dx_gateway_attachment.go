@@ -370,7 +370,7 @@ func waitDirectConnectGatewayAttachmentCreated(ctx context.Context, conn *networ
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -389,7 +389,7 @@ func waitDirectConnectGatewayAttachmentUpdated(ctx context.Context, conn *networ
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -409,7 +409,7 @@ func waitDirectConnectGatewayAttachmentDeleted(ctx context.Context, conn *networ
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -428,7 +428,7 @@ func waitDirectConnectGatewayAttachmentAvailable(ctx context.Context, conn *netw
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -447,7 +447,7 @@ func waitDirectConnectGatewayAttachmentRejected(ctx context.Context, conn *netwo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
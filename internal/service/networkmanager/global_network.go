@@ -364,7 +364,7 @@ func waitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.Client,
 		Refresh: statusGlobalNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalNetwork); ok {
 		return output, err
@@ -382,7 +382,7 @@ func waitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.Client,
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalNetwork); ok {
 		return output, err
@@ -399,7 +399,7 @@ func waitGlobalNetworkUpdated(ctx context.Context, conn *networkmanager.Client,
 		Refresh: statusGlobalNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalNetwork); ok {
 		return output, err
@@ -351,7 +351,7 @@ func waitLinkCreated(ctx context.Context, conn *networkmanager.Client, globalNet
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Link); ok {
 		return output, err
@@ -368,7 +368,7 @@ func waitLinkDeleted(ctx context.Context, conn *networkmanager.Client, globalNet
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Link); ok {
 		return output, err
@@ -385,7 +385,7 @@ func waitLinkUpdated(ctx context.Context, conn *networkmanager.Client, globalNet
 		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Link); ok {
 		return output, err
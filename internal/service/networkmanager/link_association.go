@@ -249,7 +249,7 @@ func waitLinkAssociationCreated(ctx context.Context, conn *networkmanager.Client
 		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.LinkAssociation); ok {
 		return output, err
@@ -266,7 +266,7 @@ func waitLinkAssociationDeleted(ctx context.Context, conn *networkmanager.Client
 		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.LinkAssociation); ok {
 		return output, err
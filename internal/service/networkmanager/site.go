@@ -340,7 +340,7 @@ func waitSiteCreated(ctx context.Context, conn *networkmanager.Client, globalNet
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Site); ok {
 		return output, err
@@ -357,7 +357,7 @@ func waitSiteDeleted(ctx context.Context, conn *networkmanager.Client, globalNet
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Site); ok {
 		return output, err
@@ -374,7 +374,7 @@ func waitSiteUpdated(ctx context.Context, conn *networkmanager.Client, globalNet
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Site); ok {
 		return output, err
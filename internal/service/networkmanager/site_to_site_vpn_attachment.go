@@ -257,7 +257,7 @@ func waitSiteToSiteVPNAttachmentCreated(ctx context.Context, conn *networkmanage
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SiteToSiteVpnAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -277,7 +277,7 @@ func waitSiteToSiteVPNAttachmentDeleted(ctx context.Context, conn *networkmanage
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SiteToSiteVpnAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -296,7 +296,7 @@ func waitSiteToSiteVPNAttachmentAvailable(ctx context.Context, conn *networkmana
 		Refresh: statusSiteToSiteVPNAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SiteToSiteVpnAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -4,6 +4,7 @@
 package networkmanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -19,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_global_network",
 		F:    sweepGlobalNetworks,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_core_network",
 		F:    sweepCoreNetworks,
 		Dependencies: []string{
@@ -40,22 +40,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_connect_attachment",
 		F:    sweepConnectAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_dx_gateway_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_dx_gateway_attachment",
 		F:    sweepDirectConnectGatewayAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_site_to_site_vpn_attachment",
 		F:    sweepSiteToSiteVPNAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_peering",
 		F:    sweepTransitGatewayPeerings,
 		Dependencies: []string{
@@ -63,17 +63,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_route_table_attachment",
 		F:    sweepTransitGatewayRouteTableAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_vpc_attachment",
 		F:    sweepVPCAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_site",
 		F:    sweepSites,
 		Dependencies: []string{
@@ -82,7 +82,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_device",
 		F:    sweepDevices,
 		Dependencies: []string{
@@ -90,7 +90,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_link",
 		F:    sweepLinks,
 		Dependencies: []string{
@@ -98,7 +98,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_link_association",
 		F:    sweepLinkAssociations,
 		Dependencies: []string{
@@ -106,14 +106,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_networkmanager_connection",
 		F:    sweepConnections,
 	})
 }
 
-func sweepGlobalNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepGlobalNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -153,8 +152,7 @@ func sweepGlobalNetworks(region string) error {
 	return nil
 }
 
-func sweepCoreNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepCoreNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -194,8 +192,7 @@ func sweepCoreNetworks(region string) error {
 	return nil
 }
 
-func sweepConnectAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnectAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -237,8 +234,7 @@ func sweepConnectAttachments(region string) error {
 	return nil
 }
 
-func sweepDirectConnectGatewayAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectConnectGatewayAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -283,8 +279,7 @@ func sweepDirectConnectGatewayAttachments(region string) error {
 	return nil
 }
 
-func sweepSiteToSiteVPNAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepSiteToSiteVPNAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -326,8 +321,7 @@ func sweepSiteToSiteVPNAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeerings(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeerings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -369,8 +363,7 @@ func sweepTransitGatewayPeerings(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayRouteTableAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayRouteTableAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -412,8 +405,7 @@ func sweepTransitGatewayRouteTableAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -455,8 +447,7 @@ func sweepVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepSites(region string) error {
-	ctx := sweep.Context(region)
+func sweepSites(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -517,8 +508,7 @@ func sweepSites(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDevices(region string) error {
-	ctx := sweep.Context(region)
+func sweepDevices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -579,8 +569,7 @@ func sweepDevices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -641,8 +630,7 @@ func sweepLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLinkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -702,8 +690,7 @@ func sweepLinkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -270,7 +270,7 @@ func waitTransitGatewayConnectPeerAssociationCreated(ctx context.Context, conn *
 		Refresh: statusTransitGatewayConnectPeerAssociationState(ctx, conn, globalNetworkID, connectPeerARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayConnectPeerAssociation); ok {
 		return output, err
@@ -287,7 +287,7 @@ func waitTransitGatewayConnectPeerAssociationDeleted(ctx context.Context, conn *
 		Refresh: statusTransitGatewayConnectPeerAssociationState(ctx, conn, globalNetworkID, connectPeerARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayConnectPeerAssociation); ok {
 		return output, err
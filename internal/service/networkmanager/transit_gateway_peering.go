@@ -239,7 +239,7 @@ func waitTransitGatewayPeeringCreated(ctx context.Context, conn *networkmanager.
 		Refresh: statusTransitGatewayPeeringState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPeering); ok {
 		return output, err
@@ -256,7 +256,7 @@ func waitTransitGatewayPeeringDeleted(ctx context.Context, conn *networkmanager.
 		Refresh: statusTransitGatewayPeeringState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPeering); ok {
 		return output, err
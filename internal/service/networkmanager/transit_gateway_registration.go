@@ -254,7 +254,7 @@ func waitTransitGatewayRegistrationCreated(ctx context.Context, conn *networkman
 		Refresh: statusTransitGatewayRegistrationState(ctx, conn, globalNetworkID, transitGatewayARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRegistration); ok {
 		if state := output.State.Code; state == awstypes.TransitGatewayRegistrationStateFailed {
@@ -275,7 +275,7 @@ func waitTransitGatewayRegistrationDeleted(ctx context.Context, conn *networkman
 		Refresh: statusTransitGatewayRegistrationState(ctx, conn, globalNetworkID, transitGatewayARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRegistration); ok {
 		if state := output.State.Code; state == awstypes.TransitGatewayRegistrationStateFailed {
@@ -243,7 +243,7 @@ func waitTransitGatewayRouteTableAttachmentCreated(ctx context.Context, conn *ne
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRouteTableAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -263,7 +263,7 @@ func waitTransitGatewayRouteTableAttachmentDeleted(ctx context.Context, conn *ne
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRouteTableAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -282,7 +282,7 @@ func waitTransitGatewayRouteTableAttachmentAvailable(ctx context.Context, conn *
 		Refresh: statusTransitGatewayRouteTableAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRouteTableAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -382,7 +382,7 @@ func waitVPCAttachmentCreated(ctx context.Context, conn *networkmanager.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -401,7 +401,7 @@ func waitVPCAttachmentAvailable(ctx context.Context, conn *networkmanager.Client
 		Refresh: statusVPCAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -420,7 +420,7 @@ func waitVPCAttachmenRejected(ctx context.Context, conn *networkmanager.Client,
 		Refresh: statusVPCAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -440,7 +440,7 @@ func waitVPCAttachmentDeleted(ctx context.Context, conn *networkmanager.Client,
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
@@ -459,7 +459,7 @@ func waitVPCAttachmentUpdated(ctx context.Context, conn *networkmanager.Client,
 		Refresh: statusVPCAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		tfresource.SetLastError(err, attachmentsError(output.Attachment.LastModificationErrors))
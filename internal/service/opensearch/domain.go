@@ -159,9 +159,10 @@ func resourceDomain() *schema.Resource {
 										Optional: true,
 									},
 									"master_user_password": {
-										Type:      schema.TypeString,
-										Optional:  true,
-										Sensitive: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										ConflictsWith: []string{"master_user_password_wo"},
 									},
 								},
 							},
@@ -514,6 +515,18 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"master_user_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+			},
+			"master_user_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"master_user_password_wo"},
+			},
 			"node_to_node_encryption": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -688,6 +701,17 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if v, ok := d.GetOk("advanced_security_options"); ok {
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]interface{}))
+
+		// get write-only value from configuration
+		masterUserPasswordWO, di := getMasterUserPasswordWO(d)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if masterUserPasswordWO != "" && input.AdvancedSecurityOptions.MasterUserOptions != nil {
+			input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword = aws.String(masterUserPasswordWO)
+		}
 	}
 
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 {
@@ -1006,8 +1030,19 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AdvancedOptions = flex.ExpandStringValueMap(d.Get("advanced_options").(map[string]interface{}))
 		}
 
-		if d.HasChange("advanced_security_options") {
+		if d.HasChanges("advanced_security_options", "master_user_password_wo_version") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]interface{}))
+
+			// get write-only value from configuration
+			masterUserPasswordWO, di := getMasterUserPasswordWO(d)
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if masterUserPasswordWO != "" && input.AdvancedSecurityOptions.MasterUserOptions != nil {
+				input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword = aws.String(masterUserPasswordWO)
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
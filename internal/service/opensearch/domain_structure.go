@@ -8,7 +8,10 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -349,6 +352,15 @@ func getMasterUserOptions(d *schema.ResourceData) []interface{} {
 	return []interface{}{}
 }
 
+// getMasterUserPasswordWO returns the write-only master user password from the configuration.
+func getMasterUserPasswordWO(d *schema.ResourceData) (string, diag.Diagnostics) {
+	if len(getMasterUserOptions(d)) == 0 {
+		return "", nil
+	}
+
+	return flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+}
+
 func expandLogPublishingOptions(m *schema.Set) map[string]awstypes.LogPublishingOption {
 	options := make(map[string]awstypes.LogPublishingOption)
 
@@ -231,7 +231,7 @@ func waitInboundConnectionAccepted(ctx context.Context, conn *opensearch.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
@@ -250,7 +250,7 @@ func waitInboundConnectionRejected(ctx context.Context, conn *opensearch.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
@@ -269,7 +269,7 @@ func waitInboundConnectionDeleted(ctx context.Context, conn *opensearch.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
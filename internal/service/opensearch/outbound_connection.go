@@ -315,7 +315,7 @@ func waitOutboundConnectionCreated(ctx context.Context, conn *opensearch.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.OutboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
@@ -339,7 +339,7 @@ func waitOutboundConnectionDeleted(ctx context.Context, conn *opensearch.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.OutboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConnectionStatus.Message)))
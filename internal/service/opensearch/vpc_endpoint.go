@@ -298,7 +298,7 @@ func waitVPCEndpointCreated(ctx context.Context, conn *opensearch.Client, id str
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -311,7 +311,7 @@ func waitVPCEndpointUpdated(ctx context.Context, conn *opensearch.Client, id str
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -324,7 +324,7 @@ func waitVPCEndpointDeleted(ctx context.Context, conn *opensearch.Client, id str
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
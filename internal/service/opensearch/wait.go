@@ -31,7 +31,7 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearch.Client, name str
 		Delay:      domainUpgradeSuccessDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearch.GetUpgradeStatusOutput); ok {
 		return output, err
@@ -156,7 +156,7 @@ func waitForDomainDelete(ctx context.Context, conn *opensearch.Client, domainNam
 		ContinuousTargetOccurence: 3,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -328,7 +328,7 @@ func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Clien
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CollectionDetail); ok {
 		if output.Status == awstypes.CollectionStatusFailed {
@@ -351,7 +351,7 @@ func waitCollectionDeleted(ctx context.Context, conn *opensearchserverless.Clien
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CollectionDetail); ok {
 		if output.Status == awstypes.CollectionStatusFailed {
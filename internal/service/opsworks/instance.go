@@ -924,7 +924,7 @@ func waitInstanceDeleted(ctx context.Context, conn *opsworks.Client, instanceId
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -937,7 +937,7 @@ func waitInstanceStarted(ctx context.Context, conn *opsworks.Client, instanceId
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -950,7 +950,7 @@ func waitInstanceStopped(ctx context.Context, conn *opsworks.Client, instanceId
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
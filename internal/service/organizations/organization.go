@@ -644,7 +644,7 @@ func waitDefaultRootPolicyTypeDisabled(ctx context.Context, conn *organizations.
 		Timeout: 5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PolicyTypeSummary); ok {
 		return output, err
@@ -661,7 +661,7 @@ func waitDefaultRootPolicyTypeEnabled(ctx context.Context, conn *organizations.C
 		Timeout: 5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PolicyTypeSummary); ok {
 		return output, err
@@ -372,7 +372,7 @@ func waitApplicationActive(ctx context.Context, conn *qbusiness.Client, id strin
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qbusiness.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(string(output.Status)))
@@ -391,7 +391,7 @@ func waitApplicationDeleted(ctx context.Context, conn *qbusiness.Client, id stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*qbusiness.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(string(output.Status)))
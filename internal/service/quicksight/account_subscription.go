@@ -280,7 +280,7 @@ func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AccountInfo); ok {
 		return output, err
@@ -297,7 +297,7 @@ func waitAccountSubscriptionDeleted(ctx context.Context, conn *quicksight.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AccountInfo); ok {
 		return output, err
@@ -457,7 +457,7 @@ func waitVPCConnectionCreated(ctx context.Context, conn *quicksight.Client, awsA
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VPCConnection); ok {
 		return output, err
@@ -475,7 +475,7 @@ func waitVPCConnectionUpdated(ctx context.Context, conn *quicksight.Client, awsA
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VPCConnection); ok {
 		return output, err
@@ -493,7 +493,7 @@ func waitVPCConnectionDeleted(ctx context.Context, conn *quicksight.Client, awsA
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VPCConnection); ok {
 		return output, err
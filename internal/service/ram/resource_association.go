@@ -244,7 +244,7 @@ func waitResourceAssociationCreated(ctx context.Context, conn *ram.Client, resou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -266,7 +266,7 @@ func waitResourceAssociationDeleted(ctx context.Context, conn *ram.Client, resou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthToken = "Ephemeral Resource Auth Token"
+)
+
+const (
+	// authTokenExpiresIn is the validity period of an RDS IAM database authentication token, as set by feature/rds/auth.
+	authTokenExpiresIn = 15 * time.Minute
+)
+
+// @EphemeralResource(aws_rds_auth_token, name="Auth Token")
+func newEphemeralAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthToken{}, nil
+}
+
+type ephemeralAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_rds_auth_token"
+}
+
+func (e *ephemeralAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthTokenData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is signed locally; no API call is made.
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+	credentialsProvider := e.Meta().CredentialsProvider(ctx)
+	if credentialsProvider == nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameAuthToken, endpoint, nil),
+			"no AWS credentials provider configured",
+		)
+		return
+	}
+
+	token, err := buildAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), credentialsProvider)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAuthTokenData struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+	Token    types.String `tfsdk:"token"`
+	Username types.String `tfsdk:"username"`
+}
+
+// buildAuthToken returns an RDS IAM database authentication token for the specified endpoint (host:port) and database user.
+// An error is returned if the credentials used to sign the token expire before the token does.
+func buildAuthToken(ctx context.Context, endpoint, region, dbUser string, credentialsProvider aws.CredentialsProvider) (string, error) {
+	creds, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	if creds.CanExpire && creds.Expires.Before(time.Now().Add(authTokenExpiresIn)) {
+		return "", fmt.Errorf("AWS credentials expire at %s, before the authentication token's %s lifetime", creds.Expires.Format(time.RFC3339), authTokenExpiresIn)
+	}
+
+	// Sign with the credentials already retrieved so that they are the ones checked above.
+	return auth.BuildAuthToken(ctx, endpoint, region, dbUser, credentials.StaticCredentialsProvider{Value: creds})
+}
@@ -2104,7 +2104,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *rds.Client, id string, wa
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -2131,7 +2131,7 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -2165,7 +2165,7 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -2191,7 +2191,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -285,7 +285,7 @@ func waitClusterEndpointCreated(ctx context.Context, conn *rds.Client, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBClusterEndpoint); ok {
 		return output, err
@@ -304,7 +304,7 @@ func waitClusterEndpointDeleted(ctx context.Context, conn *rds.Client, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBClusterEndpoint); ok {
 		return output, err
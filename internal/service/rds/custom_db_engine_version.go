@@ -459,7 +459,7 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.Client, eng
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBEngineVersion); ok {
 		return output, err
@@ -476,7 +476,7 @@ func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.Client, eng
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBEngineVersion); ok {
 		return output, err
@@ -493,7 +493,7 @@ func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.Client, eng
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBEngineVersion); ok {
 		return output, err
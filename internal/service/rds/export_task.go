@@ -316,7 +316,7 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
 		return out, err
 	}
@@ -332,7 +332,7 @@ func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
 		return out, err
 	}
@@ -485,7 +485,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *rds.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GlobalCluster); ok {
 		return output, err
@@ -503,7 +503,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *rds.Client, id string,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GlobalCluster); ok {
 		return output, err
@@ -521,7 +521,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *rds.Client, id string,
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GlobalCluster); ok {
 		return output, err
@@ -777,7 +777,7 @@ func waitGlobalClusterMemberUpdated(ctx context.Context, conn *rds.Client, id st
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
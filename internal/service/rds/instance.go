@@ -2923,7 +2923,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
@@ -2959,7 +2959,7 @@ func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, tim
 		MinTimeout:                3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
@@ -3001,7 +3001,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.Client, id string, tim
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
@@ -3101,7 +3101,7 @@ func waitBlueGreenDeploymentAvailable(ctx context.Context, conn *rds.Client, id
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.BlueGreenDeployment); ok {
 		return output, err
@@ -3127,7 +3127,7 @@ func waitBlueGreenDeploymentSwitchoverCompleted(ctx context.Context, conn *rds.C
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.BlueGreenDeployment); ok {
 		if status := aws.ToString(output.Status); status == "INVALID_CONFIGURATION" || status == "SWITCHOVER_FAILED" {
@@ -3157,7 +3157,7 @@ func waitBlueGreenDeploymentDeleted(ctx context.Context, conn *rds.Client, id st
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.BlueGreenDeployment); ok {
 		return output, err
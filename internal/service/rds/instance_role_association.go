@@ -226,7 +226,7 @@ func waitDBInstanceRoleAssociationCreated(ctx context.Context, conn *rds.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstanceRole); ok {
 		return output, err
@@ -243,7 +243,7 @@ func waitDBInstanceRoleAssociationDeleted(ctx context.Context, conn *rds.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstanceRole); ok {
 		return output, err
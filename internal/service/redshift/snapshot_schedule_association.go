@@ -214,7 +214,7 @@ func waitSnapshotScheduleAssociationCreated(ctx context.Context, conn *redshift.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ClusterAssociatedToSchedule); ok {
 		return output, err
@@ -235,7 +235,7 @@ func waitSnapshotScheduleAssociationDeleted(ctx context.Context, conn *redshift.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ClusterAssociatedToSchedule); ok {
 		return output, err
@@ -31,7 +31,7 @@ func waitClusterCreated(ctx context.Context, conn *redshift.Client, id string, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
@@ -50,7 +50,7 @@ func waitClusterDeleted(ctx context.Context, conn *redshift.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
@@ -69,7 +69,7 @@ func waitClusterUpdated(ctx context.Context, conn *redshift.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
@@ -88,7 +88,7 @@ func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Cli
 		Timeout: clusterRelocationStatusResolvedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
@@ -106,7 +106,7 @@ func waitClusterRebooted(ctx context.Context, conn *redshift.Client, id string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
@@ -126,7 +126,7 @@ func waitClusterAquaApplied(ctx context.Context, conn *redshift.Client, id strin
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ClusterStatus)))
@@ -147,7 +147,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshift.Client, id str
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EndpointAccess); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.EndpointStatus)))
@@ -168,7 +168,7 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshift.Client, id st
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EndpointAccess); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.EndpointStatus)))
@@ -189,7 +189,7 @@ func waitClusterSnapshotCreated(ctx context.Context, conn *redshift.Client, id s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status)))
@@ -210,7 +210,7 @@ func waitClusterSnapshotDeleted(ctx context.Context, conn *redshift.Client, id s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status)))
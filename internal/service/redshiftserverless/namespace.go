@@ -387,7 +387,7 @@ func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Client,
 		Timeout: namespaceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Namespace); ok {
 		return output, err
@@ -404,7 +404,7 @@ func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.Client,
 		Timeout: namespaceUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Namespace); ok {
 		return output, err
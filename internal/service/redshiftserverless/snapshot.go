@@ -236,7 +236,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.Client,
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		return output, err
@@ -253,7 +253,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.Client, n
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		return output, err
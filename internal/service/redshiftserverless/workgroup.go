@@ -556,7 +556,7 @@ func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Client
 		Timeout: wait,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Workgroup); ok {
 		return output, err
@@ -573,7 +573,7 @@ func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Client,
 		Timeout: wait,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Workgroup); ok {
 		return output, err
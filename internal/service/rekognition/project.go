@@ -267,7 +267,7 @@ func waitProjectCreated(ctx context.Context, conn *rekognition.Client, name stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ProjectDescription); ok {
 		return out, err
 	}
@@ -285,7 +285,7 @@ func waitProjectDeleted(ctx context.Context, conn *rekognition.Client, name stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ProjectDescription); ok {
 		return out, err
 	}
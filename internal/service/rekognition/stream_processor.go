@@ -733,7 +733,7 @@ func waitStreamProcessorCreated(ctx context.Context, conn *rekognition.Client, n
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
 		return out, err
 	}
@@ -751,7 +751,7 @@ func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Client, n
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
 		return out, err
 	}
@@ -774,7 +774,7 @@ func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Client, n
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
 		return out, err
 	}
@@ -449,7 +449,7 @@ func waitResiliencyPolicyCreated(ctx context.Context, conn *resiliencehub.Client
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ResiliencyPolicy); ok {
 		return out, err
 	}
@@ -467,7 +467,7 @@ func waitResiliencyPolicyUpdated(ctx context.Context, conn *resiliencehub.Client
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ResiliencyPolicy); ok {
 		return out, err
 	}
@@ -483,7 +483,7 @@ func waitResiliencyPolicyDeleted(ctx context.Context, conn *resiliencehub.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ResiliencyPolicy); ok {
 		return out, err
 	}
@@ -306,7 +306,7 @@ func waitIndexCreated(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -323,7 +323,7 @@ func waitIndexUpdated(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -340,7 +340,7 @@ func waitIndexDeleted(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -225,7 +225,7 @@ func waitResourceCreated(ctx context.Context, conn *resourcegroups.Client, group
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ListGroupResourcesItem); ok {
 		return output, err
@@ -242,7 +242,7 @@ func waitResourceDeleted(ctx context.Context, conn *resourcegroups.Client, group
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ListGroupResourcesItem); ok {
 		return output, err
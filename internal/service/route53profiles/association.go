@@ -262,7 +262,7 @@ func waitAssociationCreated(ctx context.Context, conn *route53profiles.Client, i
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ProfileAssociation); ok {
 		return out, err
 	}
@@ -278,7 +278,7 @@ func waitAssociationDeleted(ctx context.Context, conn *route53profiles.Client, i
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ProfileAssociation); ok {
 		return out, err
 	}
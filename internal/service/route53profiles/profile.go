@@ -233,7 +233,7 @@ func waitProfileCreated(ctx context.Context, conn *route53profiles.Client, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Profile); ok {
 		return out, err
 	}
@@ -249,7 +249,7 @@ func waitProfileDeleted(ctx context.Context, conn *route53profiles.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Profile); ok {
 		return out, err
 	}
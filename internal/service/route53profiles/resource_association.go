@@ -237,7 +237,7 @@ func waitResourceAssociationCreated(ctx context.Context, conn *route53profiles.C
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
 		return out, err
 	}
@@ -253,7 +253,7 @@ func waitResourceAssociationDeleted(ctx context.Context, conn *route53profiles.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
 		return out, err
 	}
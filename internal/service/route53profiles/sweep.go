@@ -4,12 +4,12 @@
 package route53profiles
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_route53profiles_profile", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_route53profiles_profile",
 		F:    sweepProfiles,
 		Dependencies: []string{
@@ -25,14 +25,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53profiles_association", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_route53profiles_association",
 		F:    sweepProfileAssociations,
 	})
 }
 
-func sweepProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -67,8 +66,7 @@ func sweepProfiles(region string) error {
 	return nil
 }
 
-func sweepProfileAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepProfileAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53recoverycontrolconfig/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func waitClusterCreated(ctx context.Context, conn *r53rcc.Client, clusterArn str
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
@@ -46,7 +47,7 @@ func waitClusterDeleted(ctx context.Context, conn *r53rcc.Client, clusterArn str
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Cluster); ok {
 		return output, err
@@ -64,7 +65,7 @@ func waitRoutingControlCreated(ctx context.Context, conn *r53rcc.Client, routing
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RoutingControl); ok {
 		return output, err
@@ -83,7 +84,7 @@ func waitRoutingControlDeleted(ctx context.Context, conn *r53rcc.Client, routing
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RoutingControl); ok {
 		return output, err
@@ -101,7 +102,7 @@ func waitControlPanelCreated(ctx context.Context, conn *r53rcc.Client, controlPa
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ControlPanel); ok {
 		return output, err
@@ -120,7 +121,7 @@ func waitControlPanelDeleted(ctx context.Context, conn *r53rcc.Client, controlPa
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ControlPanel); ok {
 		return output, err
@@ -138,7 +139,7 @@ func waitSafetyRuleCreated(ctx context.Context, conn *r53rcc.Client, safetyRuleA
 		MinTimeout: minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
 		return output, err
@@ -157,7 +158,7 @@ func waitSafetyRuleDeleted(ctx context.Context, conn *r53rcc.Client, safetyRuleA
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
 		return output, err
@@ -204,7 +204,7 @@ func waitDNSSECConfigCreated(ctx context.Context, conn *route53resolver.Client,
 		Timeout: dnssecConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverDnssecConfig); ok {
 		return output, err
@@ -221,7 +221,7 @@ func waitDNSSECConfigDeleted(ctx context.Context, conn *route53resolver.Client,
 		Timeout: dnssecConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverDnssecConfig); ok {
 		return output, err
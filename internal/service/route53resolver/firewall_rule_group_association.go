@@ -244,7 +244,7 @@ func waitFirewallRuleGroupAssociationCreated(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -263,7 +263,7 @@ func waitFirewallRuleGroupAssociationUpdated(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -282,7 +282,7 @@ func waitFirewallRuleGroupAssociationDeleted(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
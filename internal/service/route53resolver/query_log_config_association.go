@@ -174,7 +174,7 @@ func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53reso
 		Timeout: queryLogConfigAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverQueryLogConfigAssociation); ok {
 		if status := output.Status; status == awstypes.ResolverQueryLogConfigAssociationStatusFailed {
@@ -195,7 +195,7 @@ func waitQueryLogConfigAssociationDeleted(ctx context.Context, conn *route53reso
 		Timeout: queryLogConfigAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverQueryLogConfigAssociation); ok {
 		if status := output.Status; status == awstypes.ResolverQueryLogConfigAssociationStatusFailed {
@@ -50,6 +50,7 @@ const (
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
+// @IdentityAttribute("bucket")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
 	return &schema.Resource{
@@ -1617,6 +1618,23 @@ func findBucket(ctx context.Context, conn *s3.Client, bucket string, optFns ...f
 	return err
 }
 
+func findBuckets(ctx context.Context, conn *s3.Client, input *s3.ListBucketsInput) ([]types.Bucket, error) {
+	var output []types.Bucket
+
+	pages := s3.NewListBucketsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Buckets...)
+	}
+
+	return output, nil
+}
+
 func findBucketRegion(ctx context.Context, awsClient *conns.AWSClient, bucket string, optFns ...func(*s3.Options)) (string, error) {
 	optFns = append(slices.Clone(optFns),
 		func(o *s3.Options) {
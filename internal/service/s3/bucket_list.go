@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource() itypes.ListResourceForSDK {
+	return &bucketListResource{}
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (*bucketListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket"
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().S3Client(ctx)
+
+	// Only list general purpose buckets in the current Region.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(l.Meta().Region(ctx)),
+	}
+	output, err := findBuckets(ctx, conn, &input)
+
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("listing S3 Buckets", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, v := range output {
+			name := aws.ToString(v.Name)
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set(names.AttrBucket, name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+			l.SetResult(ctx, request.IncludeResource, d, &result)
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
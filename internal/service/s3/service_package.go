@@ -47,3 +47,10 @@ func (p *servicePackage) withExtraOptions(ctx context.Context, config map[string
 		},
 	}
 }
+
+// `aws_s3_object` replaces `aws_s3_bucket_object`, adding checksum and `override_provider` attributes.
+func (p *servicePackage) SDKResourceStateMovers(context.Context) []conns.SDKResourceStateMover {
+	return []conns.SDKResourceStateMover{
+		{SourceTypeName: "aws_s3_bucket_object", TargetTypeName: "aws_s3_object"},
+	}
+}
@@ -169,24 +169,21 @@ func sweepBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 	input := s3.ListBucketsInput{
 		BucketRegion: aws.String(client.Region(ctx)),
 	}
-	pages := s3.NewListBucketsPaginator(conn, &input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
+	buckets, err := findBuckets(ctx, conn, &input)
+	if err != nil {
+		return nil, err
+	}
 
-		for _, bucket := range page.Buckets {
-			ctx = tflog.SetField(ctx, logKeyBucketName, aws.ToString(bucket.Name))
-			if !bucketNameFilter(ctx, bucket) {
-				continue
-			}
+	for _, bucket := range buckets {
+		ctx = tflog.SetField(ctx, logKeyBucketName, aws.ToString(bucket.Name))
+		if !bucketNameFilter(ctx, bucket) {
+			continue
+		}
 
-			d := r.Data(nil)
-			d.SetId(aws.ToString(bucket.Name))
+		d := r.Data(nil)
+		d.SetId(aws.ToString(bucket.Name))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 	}
 
 	return sweepResources, nil
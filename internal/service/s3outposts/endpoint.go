@@ -278,7 +278,7 @@ func waitEndpointStatusCreated(ctx context.Context, conn *s3outposts.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Endpoint); ok {
 		if failedReason := output.FailedReason; failedReason != nil {
@@ -421,7 +421,7 @@ func waitEndpointInService(ctx context.Context, conn *sagemaker.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeEndpointOutput); ok {
 		if failureReason := output.FailureReason; failureReason != nil {
@@ -445,7 +445,7 @@ func waitEndpointDeleted(ctx context.Context, conn *sagemaker.Client, name strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeEndpointOutput); ok {
 		if failureReason := output.FailureReason; failureReason != nil {
@@ -439,7 +439,7 @@ func startNotebookInstance(ctx context.Context, conn *sagemaker.Client, id strin
 	}
 	// StartNotebookInstance sometimes doesn't take so we'll check for a state change and if
 	// it doesn't change we'll send another request
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.StartNotebookInstance(ctx, startOpts)
 		if err != nil {
 			return retry.NonRetryableError(fmt.Errorf("starting: %s", err))
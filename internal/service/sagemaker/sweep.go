@@ -4,6 +4,7 @@
 package sagemaker
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,34 +15,33 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_app_image_config",
 		F:    sweepAppImagesConfig,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_app",
 		F:    sweepApps,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    sweepCodeRepositories,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_device_fleet",
 		F:    sweepDeviceFleets,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -52,7 +52,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_endpoint_configuration",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -60,7 +60,7 @@ func RegisterSweepers() {
 		F: sweepEndpointConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -69,42 +69,42 @@ func RegisterSweepers() {
 		F: sweepEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    sweepFeatureGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_flow_definition",
 		F:    sweepFlowDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_human_task_ui",
 		F:    sweepHumanTaskUIs,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_mlflow_tracking_server", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_mlflow_tracking_server",
 		F:    sweepMlflowTrackingServers,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    sweepModels,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    sweepNotebookInstanceLifecycleConfiguration,
 		Dependencies: []string{
@@ -112,12 +112,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_notebook_instance",
 		F:    sweepNotebookInstances,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_studio_lifecycle_config",
 		F:    sweepStudioLifecyclesConfig,
 		Dependencies: []string{
@@ -125,12 +125,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_space", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_space",
 		F:    sweepSpaces,
 		Dependencies: []string{
@@ -138,7 +138,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_user_profile",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -146,7 +146,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_workforce",
 		F:    sweepWorkforces,
 		Dependencies: []string{
@@ -154,24 +154,23 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_workteam",
 		F:    sweepWorkteams,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_pipeline", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_pipeline",
 		F:    sweepPipelines,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_hub", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_sagemaker_hub",
 		F:    sweepHubs,
 	})
 }
 
-func sweepAppImagesConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepAppImagesConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -212,8 +211,7 @@ func sweepAppImagesConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSpaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -253,8 +251,7 @@ func sweepSpaces(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+func sweepApps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -301,8 +298,7 @@ func sweepApps(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCodeRepositories(region string) error {
-	ctx := sweep.Context(region)
+func sweepCodeRepositories(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -341,8 +337,7 @@ func sweepCodeRepositories(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDeviceFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepDeviceFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -383,8 +378,7 @@ func sweepDeviceFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -424,8 +418,7 @@ func sweepDomains(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEndpointConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpointConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -467,8 +460,7 @@ func sweepEndpointConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -493,6 +485,10 @@ func sweepEndpoints(region string) error {
 		}
 
 		for _, endpoint := range page.Endpoints {
+			if sweep.DryRun(ctx, aws.ToString(endpoint.EndpointName)) {
+				continue
+			}
+
 			_, err := conn.DeleteEndpoint(ctx, &sagemaker.DeleteEndpointInput{
 				EndpointName: endpoint.EndpointName,
 			})
@@ -505,8 +501,7 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepFeatureGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepFeatureGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -545,8 +540,7 @@ func sweepFeatureGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFlowDefinitions(region string) error {
-	ctx := sweep.Context(region)
+func sweepFlowDefinitions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -585,8 +579,7 @@ func sweepFlowDefinitions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepHumanTaskUIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepHumanTaskUIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -625,8 +618,7 @@ func sweepHumanTaskUIs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+func sweepImages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -665,8 +657,7 @@ func sweepImages(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepModelPackageGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepModelPackageGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -705,8 +696,7 @@ func sweepModelPackageGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepModels(region string) error {
-	ctx := sweep.Context(region)
+func sweepModels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -745,8 +735,7 @@ func sweepModels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNotebookInstanceLifecycleConfiguration(region string) error {
-	ctx := sweep.Context(region)
+func sweepNotebookInstanceLifecycleConfiguration(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -791,8 +780,7 @@ func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNotebookInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepNotebookInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -838,8 +826,7 @@ func sweepNotebookInstances(region string) error {
 	return nil
 }
 
-func sweepStudioLifecyclesConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepStudioLifecyclesConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -878,8 +865,7 @@ func sweepStudioLifecyclesConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepUserProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -920,8 +906,7 @@ func sweepUserProfiles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkforces(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkforces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -960,8 +945,7 @@ func sweepWorkforces(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkteams(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkteams(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1000,8 +984,7 @@ func sweepWorkteams(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
+func sweepProjects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -1030,6 +1013,10 @@ func sweepProjects(region string) error {
 				continue
 			}
 
+			if sweep.DryRun(ctx, name) {
+				continue
+			}
+
 			r := resourceProject()
 			d := r.Data(nil)
 			d.SetId(name)
@@ -1043,8 +1030,7 @@ func sweepProjects(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPipelines(region string) error {
-	ctx := sweep.Context(region)
+func sweepPipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -1084,8 +1070,7 @@ func sweepPipelines(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMlflowTrackingServers(region string) error {
-	ctx := sweep.Context(region)
+func sweepMlflowTrackingServers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -1125,8 +1110,7 @@ func sweepMlflowTrackingServers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepHubs(region string) error {
-	ctx := sweep.Context(region)
+func sweepHubs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -1136,7 +1136,7 @@ func waitUserProfileInService(ctx context.Context, conn *sagemaker.Client, domai
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
@@ -1158,7 +1158,7 @@ func waitUserProfileDeleted(ctx context.Context, conn *sagemaker.Client, domainI
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
@@ -4,18 +4,18 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_scheduler_schedule_group", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_scheduler_schedule_group",
 		F:    sweepScheduleGroups,
 		Dependencies: []string{
@@ -23,14 +23,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_scheduler_schedule",
 		F:    sweepSchedules,
 	})
 }
 
-func sweepScheduleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepScheduleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -75,8 +74,7 @@ func sweepScheduleGroups(region string) error {
 	return nil
 }
 
-func sweepSchedules(region string) error {
-	ctx := sweep.Context(region)
+func sweepSchedules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
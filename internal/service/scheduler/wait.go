@@ -9,6 +9,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
@@ -21,7 +23,7 @@ func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
 		return out, err
 	}
@@ -37,7 +39,7 @@ func waitScheduleGroupDeleted(ctx context.Context, conn *scheduler.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
 		return out, err
 	}
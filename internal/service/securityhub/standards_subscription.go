@@ -214,7 +214,7 @@ func waitStandardsSubscriptionCreated(ctx context.Context, conn *securityhub.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StandardsSubscription); ok {
 		if reason := output.StandardsStatusReason; reason != nil {
@@ -238,7 +238,7 @@ func waitStandardsSubscriptionDeleted(ctx context.Context, conn *securityhub.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StandardsSubscription); ok {
 		if reason := output.StandardsStatusReason; reason != nil {
@@ -464,7 +464,7 @@ func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
@@ -481,7 +481,7 @@ func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
@@ -498,7 +498,7 @@ func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
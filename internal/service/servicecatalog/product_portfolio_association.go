@@ -81,7 +81,7 @@ func resourceProductPortfolioAssociationCreate(ctx context.Context, d *schema.Re
 	}
 
 	var output *servicecatalog.AssociateProductWithPortfolioOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 		output, err = conn.AssociateProductWithPortfolio(ctx, input)
 
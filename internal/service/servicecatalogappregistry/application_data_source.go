@@ -17,7 +17,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_servicecatalogappregistry_application", name="Application")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", tagsAll=true)
 func newDataSourceApplication(context.Context) (datasource.DataSourceWithConfigure, error) {
 	return &dataSourceApplication{}, nil
 }
@@ -51,13 +51,13 @@ func (d *dataSourceApplication) Schema(ctx context.Context, req datasource.Schem
 				ElementType: types.StringType,
 				Computed:    true,
 			},
-			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			names.AttrTags:    tftags.TagsAttributeComputedOnly(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
 	}
 }
 func (d *dataSourceApplication) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	conn := d.Meta().ServiceCatalogAppRegistryClient(ctx)
-	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
 
 	var data dataSourceApplicationData
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -75,9 +75,11 @@ func (d *dataSourceApplication) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Transparent tagging doesn't work for DataSource yet
-	data.Tags = tftags.NewMapFromMapValue(flex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()))
+	setTagsOut(ctx, out.Tags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -89,4 +91,5 @@ type dataSourceApplicationData struct {
 	Name           types.String `tfsdk:"name"`
 	ApplicationTag types.Map    `tfsdk:"application_tag"`
 	Tags           tftags.Map   `tfsdk:"tags"`
+	TagsAll        tftags.Map   `tfsdk:"tags_all"`
 }
@@ -28,7 +28,7 @@ func waitOperationSucceeded(ctx context.Context, conn *servicediscovery.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Operation); ok {
 		// Error messages can also be contained in the response with FAIL status
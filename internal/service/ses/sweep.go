@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,36 +13,38 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeDomain))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeEmailAddress))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
 }
 
-func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -64,6 +67,10 @@ func sweepConfigurationSets(region string) error {
 		for _, configurationSet := range output.ConfigurationSets {
 			name := aws.ToString(configurationSet.Name)
 
+			if sweep.DryRun(ctx, name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
 			_, err := conn.DeleteConfigurationSet(ctx, &ses.DeleteConfigurationSetInput{
 				ConfigurationSetName: aws.String(name),
@@ -88,8 +95,7 @@ func sweepConfigurationSets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentities(region, identityType string) error {
-	ctx := sweep.Context(region)
+func sweepIdentities(ctx context.Context, region, identityType string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -109,6 +115,10 @@ func sweepIdentities(region, identityType string) error {
 		}
 
 		for _, identity := range output.Identities {
+			if sweep.DryRun(ctx, identity) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Identity: %s", identity)
 			_, err = conn.DeleteIdentity(ctx, &ses.DeleteIdentityInput{
 				Identity: aws.String(identity),
@@ -133,8 +143,7 @@ func sweepIdentities(region, identityType string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepReceiptRuleSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepReceiptRuleSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -171,6 +180,10 @@ func sweepReceiptRuleSets(region string) error {
 		for _, ruleSet := range output.RuleSets {
 			name := aws.ToString(ruleSet.Name)
 
+			if sweep.DryRun(ctx, name) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
 			_, err := conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
 				RuleSetName: aws.String(name),
@@ -596,7 +596,7 @@ func waitDocumentActive(ctx context.Context, conn *ssm.Client, name string) (*aw
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusInformation)))
@@ -618,7 +618,7 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.Client, name string) (*a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusInformation)))
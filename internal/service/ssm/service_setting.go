@@ -174,7 +174,7 @@ func waitServiceSettingUpdated(ctx context.Context, conn *ssm.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServiceSetting); ok {
 		return output, err
@@ -191,7 +191,7 @@ func waitServiceSettingReset(ctx context.Context, conn *ssm.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServiceSetting); ok {
 		return output, err
@@ -4,25 +4,24 @@
 package ssmincidents
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: "aws_ssmincidents_replication_set",
 		F:    sweepReplicationSets,
 	})
 }
 
-func sweepReplicationSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationSets(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID {
 		log.Printf("[WARN] Skipping SSMIncidents Replication Sets sweep for region: %s", region)
 		return nil
@@ -330,7 +330,7 @@ func waitConfigurationManagerCreated(ctx context.Context, conn *ssmquicksetup.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
 		return out, err
 	}
@@ -346,7 +346,7 @@ func waitConfigurationManagerUpdated(ctx context.Context, conn *ssmquicksetup.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
 		return out, err
 	}
@@ -362,7 +362,7 @@ func waitConfigurationManagerDeleted(ctx context.Context, conn *ssmquicksetup.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
 		return out, err
 	}
@@ -360,7 +360,7 @@ func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.Client, in
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PermissionSetProvisioningStatus); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
@@ -531,7 +531,7 @@ func waitSMBFileShareCreated(ctx context.Context, conn *storagegateway.Client, a
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SMBFileShareInfo); ok {
 		return output, err
@@ -549,7 +549,7 @@ func waitSMBFileShareUpdated(ctx context.Context, conn *storagegateway.Client, a
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SMBFileShareInfo); ok {
 		return output, err
@@ -568,7 +568,7 @@ func waitSMBFileShareDeleted(ctx context.Context, conn *storagegateway.Client, a
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SMBFileShareInfo); ok {
 		return output, err
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Resource Assume Role"
+)
+
+// @EphemeralResource(aws_sts_assume_role, name="Assume Role")
+func newEphemeralAssumeRole(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRole) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_assume_role"
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				ElementType: fwtypes.ARNType,
+				Optional:    true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_tags": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"web_identity_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					// AssumeRoleWithWebIdentity supports none of these.
+					stringvalidator.ConflictsWith(
+						path.MatchRoot(names.AttrExternalID),
+						path.MatchRoot("session_tags"),
+						path.MatchRoot("source_identity"),
+						path.MatchRoot("transitive_tag_keys"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var policyARNs []awstypes.PolicyDescriptorType
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		policyARNs = append(policyARNs, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	var credentials *awstypes.Credentials
+	var assumedRoleUser *awstypes.AssumedRoleUser
+	var err error
+
+	if data.WebIdentityToken.IsNull() {
+		input := sts.AssumeRoleInput{
+			DurationSeconds:   fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+			ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+			Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+			PolicyArns:        policyARNs,
+			RoleArn:           fwflex.StringFromFramework(ctx, data.RoleARN),
+			RoleSessionName:   fwflex.StringFromFramework(ctx, data.RoleSessionName),
+			SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+			TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+		}
+		for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.SessionTags) {
+			input.Tags = append(input.Tags, awstypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		var output *sts.AssumeRoleOutput
+		output, err = conn.AssumeRole(ctx, &input)
+		if err == nil && (output == nil || output.Credentials == nil) {
+			err = tfresource.NewEmptyResultError(&input)
+		}
+		if err == nil {
+			credentials, assumedRoleUser = output.Credentials, output.AssumedRoleUser
+		}
+	} else {
+		input := sts.AssumeRoleWithWebIdentityInput{
+			DurationSeconds:  fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+			Policy:           fwflex.StringFromFramework(ctx, data.Policy),
+			PolicyArns:       policyARNs,
+			RoleArn:          fwflex.StringFromFramework(ctx, data.RoleARN),
+			RoleSessionName:  fwflex.StringFromFramework(ctx, data.RoleSessionName),
+			WebIdentityToken: fwflex.StringFromFramework(ctx, data.WebIdentityToken),
+		}
+
+		var output *sts.AssumeRoleWithWebIdentityOutput
+		output, err = conn.AssumeRoleWithWebIdentity(ctx, &input)
+		if err == nil && (output == nil || output.Credentials == nil) {
+			err = tfresource.NewEmptyResultError(&input)
+		}
+		if err == nil {
+			credentials, assumedRoleUser = output.Credentials, output.AssumedRoleUser
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRole, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(credentials.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+	if assumedRoleUser != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, assumedRoleUser.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, assumedRoleUser.AssumedRoleId)
+	} else {
+		data.AssumedRoleARN = types.StringNull()
+		data.AssumedRoleID = types.StringNull()
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleData struct {
+	AccessKeyID       types.String                     `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String                     `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String                     `tfsdk:"assumed_role_id"`
+	DurationSeconds   types.Int64                      `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339                `tfsdk:"expiration"`
+	ExternalID        types.String                     `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy                `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetValueOf[fwtypes.ARN]  `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN                      `tfsdk:"role_arn"`
+	RoleSessionName   types.String                     `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String                     `tfsdk:"secret_access_key"`
+	SessionTags       fwtypes.MapValueOf[types.String] `tfsdk:"session_tags"`
+	SessionToken      types.String                     `tfsdk:"session_token"`
+	SourceIdentity    types.String                     `tfsdk:"source_identity"`
+	TransitiveTagKeys fwtypes.SetValueOf[types.String] `tfsdk:"transitive_tag_keys"`
+	WebIdentityToken  types.String                     `tfsdk:"web_identity_token"`
+}
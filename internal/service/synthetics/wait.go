@@ -31,7 +31,7 @@ func waitCanaryReady(ctx context.Context, conn *synthetics.Client, name string)
 		Timeout: canaryCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Canary); ok {
 		if status := output.Status; status.State == awstypes.CanaryStateError {
@@ -57,7 +57,7 @@ func waitCanaryStopped(ctx context.Context, conn *synthetics.Client, name string
 		Timeout: canaryStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Canary); ok {
 		if status := output.Status; status.State == awstypes.CanaryStateError {
@@ -81,7 +81,7 @@ func waitCanaryRunning(ctx context.Context, conn *synthetics.Client, name string
 		Timeout: canaryRunningTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Canary); ok {
 		if status := output.Status; status.State == awstypes.CanaryStateError {
@@ -102,7 +102,7 @@ func waitCanaryDeleted(ctx context.Context, conn *synthetics.Client, name string
 		Timeout: canaryDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Canary); ok {
 		if status := output.Status; status.State == awstypes.CanaryStateError {
@@ -27,8 +27,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -426,6 +428,9 @@ func (r *resourceScheduledQuery) Schema(ctx context.Context, req resource.Schema
 					Attributes: map[string]schema.Attribute{
 						names.AttrScheduleExpression: schema.StringAttribute{
 							Required: true,
+							Validators: []validator.String{
+								fwvalidators.ScheduleExpression(schedule.WithTypes(schedule.TypeCron, schedule.TypeRate)),
+							},
 						},
 					},
 				},
@@ -767,7 +772,7 @@ func waitScheduledQueryCreated(ctx context.Context, conn *timestreamquery.Client
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ScheduledQueryDescription); ok {
 		return out, err
 	}
@@ -785,7 +790,7 @@ func waitScheduledQueryUpdated(ctx context.Context, conn *timestreamquery.Client
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ScheduledQueryDescription); ok {
 		return out, err
 	}
@@ -801,7 +806,7 @@ func waitScheduledQueryDeleted(ctx context.Context, conn *timestreamquery.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ScheduledQueryDescription); ok {
 		return out, err
 	}
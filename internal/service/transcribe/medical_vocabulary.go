@@ -207,7 +207,7 @@ func waitMedicalVocabularyCreated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -226,7 +226,7 @@ func waitMedicalVocabularyUpdated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -243,7 +243,7 @@ func waitMedicalVocabularyDeleted(ctx context.Context, conn *transcribe.Client,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -230,7 +230,7 @@ func waitVocabularyCreated(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -252,7 +252,7 @@ func waitVocabularyUpdated(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -272,7 +272,7 @@ func waitVocabularyDeleted(ctx context.Context, conn *transcribe.Client, id stri
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetVocabularyOutput); ok {
 		if status := out.VocabularyState; status == types.VocabularyStateFailed {
 			return out, errors.New(aws.ToString(out.FailureReason))
@@ -453,7 +453,7 @@ func waitResourceConfigurationCreated(ctx context.Context, conn *vpclattice.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*vpclattice.GetResourceConfigurationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
@@ -473,7 +473,7 @@ func waitResourceConfigurationUpdated(ctx context.Context, conn *vpclattice.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*vpclattice.GetResourceConfigurationOutput); ok {
 		return output, err
@@ -490,7 +490,7 @@ func waitResourceConfigurationDeleted(ctx context.Context, conn *vpclattice.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*vpclattice.GetResourceConfigurationOutput); ok {
 		return output, err
@@ -334,7 +334,7 @@ func waitResourceGatewayActive(ctx context.Context, conn *vpclattice.Client, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*vpclattice.GetResourceGatewayOutput); ok {
 		return output, err
@@ -351,7 +351,7 @@ func waitResourceGatewayDeleted(ctx context.Context, conn *vpclattice.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*vpclattice.GetResourceGatewayOutput); ok {
 		return output, err
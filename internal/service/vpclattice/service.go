@@ -240,7 +240,7 @@ func waitServiceCreated(ctx context.Context, conn *vpclattice.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
 		return out, err
 	}
@@ -256,7 +256,7 @@ func waitServiceDeleted(ctx context.Context, conn *vpclattice.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
 		return out, err
 	}
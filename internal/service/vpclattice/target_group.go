@@ -348,7 +348,7 @@ func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.CreateTargetGroupOutput); ok {
 		return out, err
 	}
@@ -366,7 +366,7 @@ func waitTargetGroupUpdated(ctx context.Context, conn *vpclattice.Client, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.UpdateTargetGroupOutput); ok {
 		return out, err
 	}
@@ -382,7 +382,7 @@ func waitTargetGroupDeleted(ctx context.Context, conn *vpclattice.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.DeleteTargetGroupOutput); ok {
 		return out, err
 	}
@@ -327,7 +327,7 @@ func testAccCheckFleetDisappears(ctx context.Context, resourceName string) resou
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err := stateConf.WaitForStateContext(ctx)
+		_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 		return err
 	}
@@ -174,7 +174,7 @@ func testAccCheckWebsiteCertificateAuthorityAssociationDisappears(ctx context.Co
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = tfresource.WaitForStateContext(ctx, stateConf)
 
 		return err
 	}
@@ -254,7 +254,7 @@ func waitConnectionAliasCreated(ctx context.Context, conn *workspaces.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectionAlias); ok {
 		return output, err
@@ -271,7 +271,7 @@ func waitConnectionAliasDeleted(ctx context.Context, conn *workspaces.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ConnectionAlias); ok {
 		return output, err
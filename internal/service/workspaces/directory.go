@@ -600,7 +600,7 @@ func waitDirectoryRegistered(ctx context.Context, conn *workspaces.Client, direc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDirectory); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
@@ -626,7 +626,7 @@ func waitDirectoryDeregistered(ctx context.Context, conn *workspaces.Client, dir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDirectory); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
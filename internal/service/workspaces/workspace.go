@@ -412,7 +412,7 @@ func waitWorkspaceAvailable(ctx context.Context, conn *workspaces.Client, worksp
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Workspace); ok {
 		return output, err
@@ -433,7 +433,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *workspaces.Client, workspac
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.Workspace); ok {
 		return v, err
@@ -453,7 +453,7 @@ func waitWorkspaceTerminated(ctx context.Context, conn *workspaces.Client, works
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Workspace); ok {
 		return output, err
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+// Context returns a context for sweeping in the specified Region.
+// Resources are only counted in a sweeper's Result when using the context passed to the sweeper.
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/candidate"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Candidate describes a resource that is a candidate for sweeping.
+type Candidate = candidate.Candidate
+
+// describer is implemented by Sweepables that can describe the resource they delete.
+type describer interface {
+	Describe(context.Context) (Candidate, error)
+}
+
+// Filters limit the resources that are swept.
+type Filters struct {
+	ExcludeNamePrefixes []string
+	ExcludeTags         map[string]string // An empty value matches any tag value.
+	IncludeNamePrefixes []string
+	IncludeTags         map[string]string // An empty value matches any tag value.
+	MinAge              time.Duration
+}
+
+// IsEmpty returns whether no filters are set.
+func (f Filters) IsEmpty() bool {
+	return len(f.ExcludeNamePrefixes) == 0 && len(f.ExcludeTags) == 0 && len(f.IncludeNamePrefixes) == 0 && len(f.IncludeTags) == 0 && f.MinAge == 0
+}
+
+// Match returns whether the candidate should be swept.
+// If not, a reason is also returned.
+func (f Filters) Match(c Candidate, now time.Time) (bool, string) {
+	name := c.Name
+	if name == "" {
+		name = c.ID
+	}
+	hasPrefix := func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	}
+
+	if slices.ContainsFunc(f.ExcludeNamePrefixes, hasPrefix) {
+		return false, fmt.Sprintf("name (%s) has an excluded prefix", name)
+	}
+	if len(f.IncludeNamePrefixes) > 0 && !slices.ContainsFunc(f.IncludeNamePrefixes, hasPrefix) {
+		return false, fmt.Sprintf("name (%s) has no included prefix", name)
+	}
+
+	if k, ok := matchTags(f.ExcludeTags, c.Tags); ok {
+		return false, fmt.Sprintf("tag (%s) is excluded", k)
+	}
+	if _, ok := matchTags(f.IncludeTags, c.Tags); len(f.IncludeTags) > 0 && !ok {
+		return false, "no included tag"
+	}
+
+	if f.MinAge > 0 {
+		// Resources whose age is unknown are never swept.
+		if c.CreatedAt.IsZero() {
+			return false, "creation time unknown"
+		}
+		if age := now.Sub(c.CreatedAt); age < f.MinAge {
+			return false, fmt.Sprintf("age (%s) is less than %s", age.Truncate(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+// matchTags returns the key of the first filter tag that matches the resource tags.
+func matchTags(filter, tags map[string]string) (string, bool) {
+	for k, v := range filter {
+		if value, ok := tags[k]; ok && (v == "" || v == value) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
+
+// options configure the resources that SweepOrchestrator deletes.
+type options struct {
+	dryRun  bool
+	filters Filters
+}
+
+// optionsFromEnv returns the sweep options configured by environment variables.
+var optionsFromEnv = sync.OnceValues(func() (options, error) {
+	var opts options
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.dryRun = dryRun
+	}
+
+	opts.filters.ExcludeNamePrefixes = splitList(os.Getenv(envvar.SweepExcludeNamePrefixes))
+	opts.filters.IncludeNamePrefixes = splitList(os.Getenv(envvar.SweepIncludeNamePrefixes))
+	opts.filters.ExcludeTags = splitTags(os.Getenv(envvar.SweepExcludeTags))
+	opts.filters.IncludeTags = splitTags(os.Getenv(envvar.SweepIncludeTags))
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		opts.filters.MinAge = minAge
+	}
+
+	return opts, nil
+})
+
+func splitList(s string) []string {
+	var v []string
+
+	for _, s := range strings.Split(s, ",") {
+		if s := strings.TrimSpace(s); s != "" {
+			v = append(v, s)
+		}
+	}
+
+	return v
+}
+
+// splitTags parses comma-separated `key` or `key=value` tags.
+func splitTags(s string) map[string]string {
+	l := splitList(s)
+	if len(l) == 0 {
+		return nil
+	}
+
+	v := make(map[string]string, len(l))
+	for _, s := range l {
+		k, value, _ := strings.Cut(s, "=")
+		v[k] = value
+	}
+
+	return v
+}
+
+// selectSweepable returns whether the sweepable should be deleted.
+// In dry-run mode each matching resource is reported and none are deleted.
+func selectSweepable(ctx context.Context, opts options, sweepable Sweepable, result *Result) (bool, error) {
+	v, ok := sweepable.(describer)
+	if !ok {
+		selectUndescribed(ctx, opts, "", result)
+
+		return false, nil
+	}
+
+	c, err := v.Describe(ctx)
+	if err != nil {
+		return false, fmt.Errorf("describing resource: %w", err)
+	}
+
+	if c.ID == "" {
+		// Resource no longer exists.
+		return false, nil
+	}
+
+	if c.Type == "" && result != nil {
+		c.Type = result.Name
+	}
+
+	ctx = tflog.SetField(ctx, "id", c.ID)
+
+	if ok, reason := opts.filters.Match(c, time.Now()); !ok {
+		tflog.Debug(ctx, "Resource excluded by sweep filters", map[string]any{
+			"reason": reason,
+		})
+		result.recordSkipped()
+
+		return false, nil
+	}
+
+	if opts.dryRun {
+		fields := map[string]any{
+			names.AttrType:   c.Type,
+			names.AttrRegion: c.Region,
+			names.AttrTags:   c.Tags,
+		}
+		if c.Name != "" {
+			fields[names.AttrName] = c.Name
+		}
+		tflog.Info(ctx, "Sweep candidate (dry run)", fields)
+		result.recordCandidate(c)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// selectUndescribed reports a resource that cannot be described and so is not deleted.
+// In dry-run mode the resource is a candidate for deletion, but it is skipped if any sweep filter is set.
+func selectUndescribed(ctx context.Context, opts options, id string, result *Result) {
+	c := Candidate{
+		ID: id,
+	}
+	if result != nil {
+		c.Type = result.Name
+	}
+	if id != "" {
+		ctx = tflog.SetField(ctx, "id", id)
+	}
+
+	if opts.dryRun && opts.filters.IsEmpty() {
+		tflog.Info(ctx, "Sweep candidate (dry run)", map[string]any{
+			"description": "resource cannot be described",
+		})
+		result.recordCandidate(c)
+	} else {
+		tflog.Warn(ctx, "Skipping resource that cannot be described for sweep filters")
+		result.recordSkipped()
+	}
+}
+
+// DryRun returns whether the sweeper running in Context must not modify the resource with the specified ID.
+// Sweepers that modify resources directly, rather than through SweepOrchestrator, must call DryRun first.
+// As for resources that SweepOrchestrator cannot describe, the resource is reported as a candidate in dry-run mode
+// and is never modified if any sweep filter is set.
+func DryRun(ctx context.Context, id string) bool {
+	opts, err := optionsFromEnv()
+	if err != nil {
+		tflog.Error(ctx, "Reading sweep options", map[string]any{
+			"error": err.Error(),
+		})
+
+		return true
+	}
+
+	if !opts.dryRun && opts.filters.IsEmpty() {
+		return false
+	}
+
+	selectUndescribed(ctx, opts, id, resultFromContext(ctx))
+
+	return true
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFiltersMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 2, 12, 0, 0, 0, time.UTC)
+	testResource := Candidate{
+		CreatedAt: now.Add(-8 * time.Hour),
+		ID:        "i-0123456789abcdef0",
+		Name:      "tf-acc-test-12345",
+		Tags: map[string]string{
+			"Environment": "test",
+			"Name":        "tf-acc-test-12345",
+		},
+	}
+
+	testCases := map[string]struct {
+		filters   Filters
+		candidate Candidate
+		expected  bool
+	}{
+		"no filters": {
+			candidate: testResource,
+			expected:  true,
+		},
+		"include name prefix": {
+			filters:   Filters{IncludeNamePrefixes: []string{"other-", "tf-acc-test-"}},
+			candidate: testResource,
+			expected:  true,
+		},
+		"include name prefix no match": {
+			filters:   Filters{IncludeNamePrefixes: []string{"other-"}},
+			candidate: testResource,
+			expected:  false,
+		},
+		"include name prefix matches ID": {
+			filters:   Filters{IncludeNamePrefixes: []string{"i-"}},
+			candidate: Candidate{ID: "i-0123456789abcdef0"},
+			expected:  true,
+		},
+		"exclude name prefix": {
+			filters:   Filters{ExcludeNamePrefixes: []string{"tf-acc-test-"}},
+			candidate: testResource,
+			expected:  false,
+		},
+		"include tag key": {
+			filters:   Filters{IncludeTags: map[string]string{"Environment": ""}},
+			candidate: testResource,
+			expected:  true,
+		},
+		"include tag value": {
+			filters:   Filters{IncludeTags: map[string]string{"Environment": "test"}},
+			candidate: testResource,
+			expected:  true,
+		},
+		"include tag value no match": {
+			filters:   Filters{IncludeTags: map[string]string{"Environment": "production"}},
+			candidate: testResource,
+			expected:  false,
+		},
+		"exclude tag": {
+			filters:   Filters{ExcludeTags: map[string]string{"DoNotSweep": ""}},
+			candidate: Candidate{ID: "id", Tags: map[string]string{"DoNotSweep": "true"}},
+			expected:  false,
+		},
+		"exclude tag no match": {
+			filters:   Filters{ExcludeTags: map[string]string{"DoNotSweep": ""}},
+			candidate: testResource,
+			expected:  true,
+		},
+		"min age": {
+			filters:   Filters{MinAge: 6 * time.Hour},
+			candidate: testResource,
+			expected:  true,
+		},
+		"min age too young": {
+			filters:   Filters{MinAge: 12 * time.Hour},
+			candidate: testResource,
+			expected:  false,
+		},
+		"min age unknown creation time": {
+			filters:   Filters{MinAge: 6 * time.Hour},
+			candidate: Candidate{ID: "id"},
+			expected:  false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filters.Match(testCase.candidate, now)
+
+			if got != testCase.expected {
+				t.Errorf("Match() = %t (%s), want %t", got, reason, testCase.expected)
+			}
+			if !got && reason == "" {
+				t.Error("Match() returned no reason")
+			}
+		})
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected map[string]string
+	}{
+		"empty": {
+			input: "",
+		},
+		"keys and values": {
+			input: "DoNotSweep, Environment=test,Owner=",
+			expected: map[string]string{
+				"DoNotSweep":  "",
+				"Environment": "test",
+				"Owner":       "",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := splitTags(testCase.input); !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("splitTags(%q) = %v, want %v", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
+
+type countingSweepable struct {
+	deletes *atomic.Int32
+}
+
+func (s countingSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	s.deletes.Add(1)
+	return nil
+}
+
+func TestDryRun_testSweepers(t *testing.T) {
+	const (
+		region      = "sweep-test-region"
+		sweeperName = "aws_sweep_test_dry_run"
+	)
+
+	defer func(f func() (options, error)) {
+		optionsFromEnv = f
+	}(optionsFromEnv)
+
+	var deletes atomic.Int32
+
+	if _, ok := sweepers[sweeperName]; !ok {
+		Register(&Sweeper{
+			Name: sweeperName,
+			F: func(ctx context.Context, region string) error {
+				// Resources deleted directly.
+				for i := range 2 {
+					if DryRun(ctx, fmt.Sprintf("direct-%d", i)) {
+						continue
+					}
+
+					deletes.Add(1)
+				}
+
+				// Resources deleted by SweepOrchestrator.
+				return SweepOrchestrator(ctx, []Sweepable{
+					countingSweepable{deletes: &deletes},
+				})
+			},
+		})
+	}
+
+	testCases := map[string]struct {
+		opts              options
+		expectedDeletes   int32
+		expectedCandidate int
+		expectedSkipped   int
+	}{
+		"no options": {
+			expectedDeletes: 3,
+		},
+		"dry run": {
+			opts:              options{dryRun: true},
+			expectedCandidate: 3,
+		},
+		"filters": {
+			opts: options{
+				filters: Filters{IncludeNamePrefixes: []string{"tf-acc-test-"}},
+			},
+			expectedSkipped: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			optionsFromEnv = func() (options, error) {
+				return testCase.opts, nil
+			}
+			deletes.Store(0)
+
+			results := runLevel(region, []string{sweeperName}, 1)
+			result := results[0]
+
+			if result.Err != nil {
+				t.Fatalf("unexpected error: %s", result.Err)
+			}
+			if got, want := deletes.Load(), testCase.expectedDeletes; got != want {
+				t.Errorf("deletes = %d, want %d", got, want)
+			}
+			if got, want := len(result.Candidates), testCase.expectedCandidate; got != want {
+				t.Errorf("candidates = %d, want %d", got, want)
+			}
+			if got, want := result.Skipped, testCase.expectedSkipped; got != want {
+				t.Errorf("skipped = %d, want %d", got, want)
+			}
+		})
+	}
+}
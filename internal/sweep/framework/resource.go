@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -19,7 +20,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/candidate"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,11 +53,12 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+// newResource returns the configured resource, its type name and a state built from the sweep attributes.
+func (sr *sweepResource) newResource(ctx context.Context) (context.Context, fwresource.ResourceWithConfigure, string, tfsdk.State, error) {
 	resource, err := sr.factory(ctx)
 
 	if err != nil {
-		return err
+		return ctx, nil, "", tfsdk.State{}, err
 	}
 
 	metadata := resourceMetadata(ctx, resource)
@@ -71,11 +77,79 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return ctx, nil, "", tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	return ctx, resource, metadata.TypeName, state, nil
+}
+
+// Describe reads the resource and returns a description of it without deleting it.
+// The returned Candidate's ID is empty if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (candidate.Candidate, error) {
+	ctx, resource, typeName, state, err := sr.newResource(ctx)
+	if err != nil {
+		return candidate.Candidate{}, err
+	}
+
+	ctx = tftags.NewContext(ctx, nil, nil) // Capture any tags set transparently.
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+	if response.Diagnostics.HasError() {
+		return candidate.Candidate{}, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	c := candidate.Candidate{
+		Region: sr.meta.Region(ctx),
+		Type:   typeName,
+	}
+
+	if response.State.Raw.IsNull() {
+		// Resource no longer exists.
+		return c, nil
+	}
+
+	if v, ok := stringAttribute(response.State, names.AttrID); ok {
+		c.ID = v
+	} else {
+		c.ID = strings.Join(tfslices.ApplyToAll(sr.attributes, func(v attribute) string {
+			return fmt.Sprint(v.value)
+		}), ",")
+	}
+
+	c.Name, _ = stringAttribute(response.State, names.AttrName)
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		c.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+	} else {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if v, ok := stringMapAttribute(response.State, k); ok && len(v) > 0 {
+				c.Tags = v
+				break
+			}
+		}
+	}
+
+	for _, k := range candidate.CreationTimeAttributes {
+		if v, ok := stringAttribute(response.State, k); ok {
+			if t, ok := candidate.ParseCreationTime(v); ok {
+				c.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	ctx, resource, _, state, err := sr.newResource(ctx)
+	if err != nil {
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
@@ -131,3 +205,55 @@ func resourceMetadata(ctx context.Context, resource fwresource.Resource) fwresou
 
 	return response
 }
+
+// rawAttribute returns the known, non-null value of the specified top-level attribute.
+func rawAttribute(state tfsdk.State, name string) (tftypes.Value, bool) {
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	value, ok := v.(tftypes.Value)
+	if !ok || !value.IsKnown() || value.IsNull() {
+		return tftypes.Value{}, false
+	}
+
+	return value, true
+}
+
+func stringAttribute(state tfsdk.State, name string) (string, bool) {
+	value, ok := rawAttribute(state, name)
+	if !ok {
+		return "", false
+	}
+
+	var v string
+	if err := value.As(&v); err != nil {
+		return "", false
+	}
+
+	return v, true
+}
+
+func stringMapAttribute(state tfsdk.State, name string) (map[string]string, bool) {
+	value, ok := rawAttribute(state, name)
+	if !ok {
+		return nil, false
+	}
+
+	var elements map[string]tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return nil, false
+	}
+
+	v := make(map[string]string, len(elements))
+	for k, element := range elements {
+		var s string
+		if err := element.As(&s); err != nil {
+			return nil, false
+		}
+		v[k] = s
+	}
+
+	return v, true
+}
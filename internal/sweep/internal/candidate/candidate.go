@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package candidate
+
+import (
+	"time"
+)
+
+// Candidate describes a resource that is a candidate for sweeping.
+type Candidate struct {
+	CreatedAt time.Time // Zero if unknown.
+	ID        string
+	Name      string
+	Region    string
+	Tags      map[string]string
+	Type      string
+}
+
+// CreationTimeAttributes are the names of resource attributes that commonly hold the resource's creation time.
+var CreationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"launch_time",
+}
+
+// ParseCreationTime parses a creation time attribute value.
+func ParseCreationTime(v string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
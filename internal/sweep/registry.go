@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+)
+
+// Sweeper is a registered resource sweeper.
+type Sweeper struct {
+	// Name is the resource type name, e.g. "aws_subnet".
+	Name string
+	// Dependencies are the names of sweepers that must run before this sweeper,
+	// i.e. the resource types whose resources must be deleted before resources of this type can be deleted.
+	Dependencies []string
+	// F lists and deletes all resources of this type in the specified Region.
+	F func(ctx context.Context, region string) error
+}
+
+// sweepers is the registry of sweepers, keyed by name.
+var sweepers = make(map[string]*Sweeper)
+
+// Register registers a sweeper.
+// It panics if a sweeper with the same name is already registered.
+func Register(s *Sweeper) {
+	if _, ok := sweepers[s.Name]; ok {
+		panic(fmt.Sprintf("duplicate sweeper: %s", s.Name))
+	}
+
+	sweepers[s.Name] = s
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const defaultParallelism = 10
+
+// RunOptions configures a dependency-ordered sweep.
+type RunOptions struct {
+	// AllowFailures continues sweeping subsequent dependency levels after a sweeper fails.
+	AllowFailures bool
+	// Filter selects the sweepers whose names contain any of the values, together with their dependencies.
+	// All sweepers are selected if empty.
+	Filter []string
+	// Parallelism is the maximum number of sweepers run concurrently within a dependency level.
+	Parallelism int
+}
+
+// Result is the outcome of running a single sweeper in a Region.
+type Result struct {
+	Name           string
+	Candidates     []Candidate // Resources that would be deleted in dry-run mode.
+	Deleted        int         // Number of resources deleted.
+	Failed         int         // Number of resources that could not be deleted.
+	Skipped        int         // Number of resources not deleted, e.g. because they are excluded by sweep filters.
+	SweeperSkipped bool        // Whether the sweeper was skipped, e.g. because the service is unavailable in the Region.
+	Err            error       // Any error returned by the sweeper.
+
+	counted bool // Whether resources were counted.
+	lock    sync.Mutex
+}
+
+func (r *Result) record(f func()) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.counted = true
+	f()
+}
+
+func (r *Result) recordDelete(err error) {
+	r.record(func() {
+		if err == nil {
+			r.Deleted++
+		} else {
+			r.Failed++
+		}
+	})
+}
+
+func (r *Result) recordCandidate(c Candidate) {
+	r.record(func() {
+		r.Candidates = append(r.Candidates, c)
+	})
+}
+
+func (r *Result) recordSkipped() {
+	r.record(func() {
+		r.Skipped++
+	})
+}
+
+func (r *Result) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(r.Name)
+	sb.WriteString(": ")
+	switch {
+	case r.SweeperSkipped:
+		sb.WriteString("skipped")
+	case r.counted:
+		fmt.Fprintf(&sb, "deleted %d, skipped %d, failed %d", r.Deleted, r.Skipped, r.Failed)
+		if len(r.Candidates) > 0 {
+			fmt.Fprintf(&sb, ", would delete %d", len(r.Candidates))
+		}
+	case r.Err == nil:
+		sb.WriteString("succeeded")
+	}
+	if r.Err != nil {
+		if r.counted {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "error: %s", r.Err)
+	}
+
+	return sb.String()
+}
+
+type resultKeyType int
+
+var resultKey resultKeyType
+
+func withResult(ctx context.Context, result *Result) context.Context {
+	return context.WithValue(ctx, resultKey, result)
+}
+
+func resultFromContext(ctx context.Context) *Result {
+	v, _ := ctx.Value(resultKey).(*Result)
+	return v
+}
+
+// SetSkipped records that the sweeper running in Context was skipped.
+func SetSkipped(ctx context.Context) {
+	if result := resultFromContext(ctx); result != nil {
+		result.lock.Lock()
+		defer result.lock.Unlock()
+
+		result.SweeperSkipped = true
+	}
+}
+
+// SkipResource records that the sweeper running in Context did not delete a resource,
+// e.g. because the resource is managed by AWS.
+func SkipResource(ctx context.Context) {
+	resultFromContext(ctx).recordSkipped()
+}
+
+// Run runs the registered sweepers in the specified Region in dependency order.
+// Sweepers at the same level of the dependency graph run concurrently.
+// A result is returned for each sweeper that ran, together with any sweeper errors.
+func Run(region string, opts RunOptions) ([]*Result, error) {
+	levels, err := dependencyLevels(sweepers, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultParallelism
+	}
+
+	ctx := Context(region)
+	var results []*Result
+	for i, level := range levels {
+		tflog.Info(ctx, "Running sweepers", map[string]any{
+			"level":    i,
+			"sweepers": level,
+		})
+
+		levelResults := runLevel(region, level, parallelism)
+		results = append(results, levelResults...)
+
+		if err := errors.Join(resultErrors(levelResults)...); err != nil && !opts.AllowFailures {
+			return results, err
+		}
+	}
+
+	return results, errors.Join(resultErrors(results)...)
+}
+
+func runLevel(region string, names []string, parallelism int) []*Result {
+	results := make([]*Result, len(names))
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		results[i] = &Result{Name: name}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := results[i]
+			ctx := withResult(Context(region), result)
+			if err := sweepers[name].F(ctx, region); err != nil {
+				result.Err = fmt.Errorf("sweeper (%s) in %s: %w", name, region, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func resultErrors(results []*Result) []error {
+	var errs []error
+
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+
+	return errs
+}
+
+// dependencyLevels returns the names of the selected sweepers grouped by level in the dependency graph.
+// Each sweeper is in a later level than all of its dependencies.
+func dependencyLevels(sweepers map[string]*Sweeper, filter []string) ([][]string, error) {
+	g := depgraph.New()
+
+	for name := range sweepers {
+		g.AddNode(name)
+	}
+
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool)
+	for name := range sweepers {
+		if len(filter) > 0 && !slices.ContainsFunc(filter, func(v string) bool { return strings.Contains(name, v) }) {
+			continue
+		}
+
+		selected[name] = true
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependency := range dependencies {
+			selected[dependency] = true
+		}
+	}
+
+	// Dependencies always precede their dependents in the overall order.
+	depth := make(map[string]int, len(order))
+	var levels [][]string
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dependency := range dependencies {
+			depth[name] = max(depth[name], depth[dependency]+1)
+		}
+
+		if !selected[name] {
+			continue
+		}
+
+		for len(levels) <= depth[name] {
+			levels = append(levels, nil)
+		}
+		levels[depth[name]] = append(levels[depth[name]], name)
+	}
+
+	levels = slices.DeleteFunc(levels, func(v []string) bool { return len(v) == 0 })
+	for _, level := range levels {
+		slices.Sort(level)
+	}
+
+	return levels, nil
+}
+
+// WriteSummary writes a per-sweeper summary of the specified results.
+func WriteSummary(w io.Writer, region string, results []*Result) {
+	var deleted, skipped, failed int
+	for _, result := range results {
+		deleted += result.Deleted
+		skipped += result.Skipped
+		failed += result.Failed
+	}
+
+	fmt.Fprintf(w, "Sweepers for region (%s): %d resources deleted, %d resources skipped, %d resources failed\n", region, deleted, skipped, failed)
+	for _, result := range results {
+		fmt.Fprintf(w, "\t- %s\n", result)
+
+		candidates := slices.SortedFunc(slices.Values(result.Candidates), func(a, b Candidate) int {
+			return strings.Compare(a.ID, b.ID)
+		})
+		for _, c := range candidates {
+			fmt.Fprintf(w, "\t\t* %s\n", formatCandidate(c))
+		}
+	}
+}
+
+func formatCandidate(c Candidate) string {
+	var sb strings.Builder
+
+	id := c.ID
+	if id == "" {
+		id = "(unknown)"
+	}
+	sb.WriteString(id)
+	if c.Name != "" && c.Name != c.ID {
+		fmt.Fprintf(&sb, " name=%s", c.Name)
+	}
+	if c.Region != "" {
+		fmt.Fprintf(&sb, " region=%s", c.Region)
+	}
+	if !c.CreatedAt.IsZero() {
+		fmt.Fprintf(&sb, " created=%s", c.CreatedAt.Format(time.RFC3339))
+	}
+	if len(c.Tags) > 0 {
+		tags := make([]string, 0, len(c.Tags))
+		for k, v := range c.Tags {
+			tags = append(tags, k+"="+v)
+		}
+		slices.Sort(tags)
+		fmt.Fprintf(&sb, " tags=%s", strings.Join(tags, ","))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestDependencyLevels(t *testing.T) {
+	t.Parallel()
+
+	f := func(context.Context, string) error { return nil }
+	sweepers := map[string]*Sweeper{
+		"aws_iam_instance_profile": {F: f},
+		"aws_iam_role":             {F: f, Dependencies: []string{"aws_iam_instance_profile"}},
+		"aws_instance":             {F: f},
+		"aws_network_interface":    {F: f, Dependencies: []string{"aws_instance"}},
+		"aws_subnet":               {F: f, Dependencies: []string{"aws_instance", "aws_network_interface"}},
+		"aws_sqs_queue":            {F: f},
+	}
+
+	testCases := map[string]struct {
+		filter        []string
+		expected      [][]string
+		expectedError bool
+	}{
+		"no filter": {
+			expected: [][]string{
+				{"aws_iam_instance_profile", "aws_instance", "aws_sqs_queue"},
+				{"aws_iam_role", "aws_network_interface"},
+				{"aws_subnet"},
+			},
+		},
+		"filter": {
+			filter: []string{"aws_subnet"},
+			expected: [][]string{
+				{"aws_instance"},
+				{"aws_network_interface"},
+				{"aws_subnet"},
+			},
+		},
+		"filter substring": {
+			filter: []string{"iam", "sqs"},
+			expected: [][]string{
+				{"aws_iam_instance_profile", "aws_sqs_queue"},
+				{"aws_iam_role"},
+			},
+		},
+		"filter no match": {
+			filter: []string{"aws_vpc"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := dependencyLevels(sweepers, testCase.filter)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("dependencyLevels() err %t, want %t", got, want)
+			}
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("dependencyLevels() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDependencyLevels_invalid(t *testing.T) {
+	t.Parallel()
+
+	f := func(context.Context, string) error { return nil }
+
+	testCases := map[string]map[string]*Sweeper{
+		"missing dependency": {
+			"aws_subnet": {F: f, Dependencies: []string{"aws_instance"}},
+		},
+		"cycle": {
+			"aws_a": {F: f, Dependencies: []string{"aws_b"}},
+			"aws_b": {F: f, Dependencies: []string{"aws_a"}},
+		},
+	}
+
+	for name, sweepers := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := dependencyLevels(sweepers, nil); err == nil {
+				t.Fatal("dependencyLevels() expected error")
+			}
+		})
+	}
+}
+
+func TestResultString(t *testing.T) {
+	t.Parallel()
+
+	skipped := &Result{Name: "aws_a"}
+	SetSkipped(withResult(context.Background(), skipped))
+
+	counted := &Result{Name: "aws_b"}
+	counted.recordDelete(nil)
+	counted.recordDelete(nil)
+	counted.recordDelete(errors.New("in use"))
+	SkipResource(withResult(context.Background(), counted))
+
+	testCases := map[string]struct {
+		result   *Result
+		expected string
+	}{
+		"skipped": {
+			result:   skipped,
+			expected: "aws_a: skipped",
+		},
+		"counted": {
+			result:   counted,
+			expected: "aws_b: deleted 2, skipped 1, failed 1",
+		},
+		"succeeded": {
+			result:   &Result{Name: "aws_c"},
+			expected: "aws_c: succeeded",
+		},
+		"error": {
+			result:   &Result{Name: "aws_d", Err: errors.New("listing")},
+			expected: "aws_d: error: listing",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.result.String(), testCase.expected; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+type testSweepable struct {
+	err error
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return s.err
+}
+
+func TestRunLevel_testSweepers(t *testing.T) {
+	const region = "sweep-test-region"
+
+	type counts struct {
+		deleted, skipped, failed int
+	}
+
+	// Sweepers delete (or fail to delete) and skip different numbers of resources.
+	expected := make(map[string]counts)
+	var names []string
+	for i := range 5 {
+		name := fmt.Sprintf("aws_sweep_test_%d", i)
+		c := counts{deleted: i + 1, skipped: i % 2, failed: i % 3}
+		expected[name] = c
+		names = append(names, name)
+
+		if _, ok := sweepers[name]; ok {
+			continue
+		}
+		Register(&Sweeper{
+			Name: name,
+			F: func(ctx context.Context, region string) error {
+				// Resources are swept in more than one batch, as some sweepers do.
+				var deleted, failed []Sweepable
+				for range c.deleted {
+					deleted = append(deleted, testSweepable{})
+				}
+				for range c.failed {
+					failed = append(failed, testSweepable{err: errors.New("in use")})
+				}
+				for range c.skipped {
+					SkipResource(ctx)
+				}
+
+				_ = SweepOrchestrator(ctx, deleted)
+				_ = SweepOrchestrator(ctx, failed)
+
+				return nil
+			},
+		})
+	}
+
+	results := runLevel(region, names, 2)
+
+	for _, result := range results {
+		want := expected[result.Name]
+		if got := (counts{deleted: result.Deleted, skipped: result.Skipped, failed: result.Failed}); got != want {
+			t.Errorf("%s: counts = %+v, want %+v", result.Name, got, want)
+		}
+	}
+
+	// The Result is not available outside a sweeper.
+	if result := resultFromContext(Context(region)); result != nil {
+		t.Errorf("Context(): unexpected Result %s", result)
+	}
+}
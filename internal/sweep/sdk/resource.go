@@ -17,7 +17,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/candidate"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +92,58 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe reads the resource and returns a description of it without deleting it.
+// The returned Candidate's ID is empty if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (candidate.Candidate, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+	ctx = tftags.NewContext(ctx, nil, nil) // Capture any tags set transparently.
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return candidate.Candidate{}, err
+	}
+
+	c := candidate.Candidate{
+		ID:     sr.d.Id(),
+		Region: sr.meta.Region(ctx),
+	}
+
+	schemaMap := sr.resource.SchemaMap()
+	if _, ok := schemaMap[names.AttrName]; ok {
+		c.Name, _ = sr.d.Get(names.AttrName).(string)
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		c.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+	} else {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if _, ok := schemaMap[k]; !ok {
+				continue
+			}
+			if v, ok := sr.d.Get(k).(map[string]any); ok && len(v) > 0 {
+				c.Tags = make(map[string]string, len(v))
+				for k, v := range v {
+					c.Tags[k], _ = v.(string)
+				}
+				break
+			}
+		}
+	}
+
+	for _, k := range candidate.CreationTimeAttributes {
+		if _, ok := schemaMap[k]; !ok {
+			continue
+		}
+		if v, ok := sr.d.Get(k).(string); ok {
+			if t, ok := candidate.ParseCreationTime(v); ok {
+				c.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return c, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
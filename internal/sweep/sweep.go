@@ -111,11 +111,29 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	opts, err := optionsFromEnv()
+	if err != nil {
+		return err
+	}
+
 	var g multierror.Group
+	result := resultFromContext(ctx)
 
 	for _, sweepable := range sweepables {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			if opts.dryRun || !opts.filters.IsEmpty() {
+				if ok, err := selectSweepable(ctx, opts, sweepable, result); err != nil {
+					result.recordDelete(err)
+					return err
+				} else if !ok {
+					return nil
+				}
+			}
+
+			err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			result.recordDelete(err)
+
+			return err
 		})
 	}
 
@@ -5,12 +5,18 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of sweepers run concurrently within a dependency level")
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +24,46 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The -sweep, -sweep-allow-failures and -sweep-run flags are defined by Terraform Plugin Testing.
+	flag.Parse()
+	if regions := flagValue("sweep"); regions != "" {
+		os.Exit(runSweepers(regions))
+	}
+
 	resource.TestMain(m)
 }
+
+func runSweepers(regions string) int {
+	opts := sweep.RunOptions{
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		Parallelism:   *flagSweepParallelism,
+	}
+	if v := flagValue("sweep-run"); v != "" {
+		opts.Filter = strings.Split(v, ",")
+	}
+
+	exitCode := 0
+	for _, region := range strings.Split(regions, ",") {
+		results, err := sweep.Run(region, opts)
+		sweep.WriteSummary(os.Stdout, region, results)
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running sweepers for region (%s): %s\n", region, err)
+			exitCode = 1
+
+			if !opts.AllowFailures {
+				break
+			}
+		}
+	}
+
+	return exitCode
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
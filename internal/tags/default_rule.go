@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"path"
+	"slices"
+)
+
+// DefaultRule scopes default tags to the resources that it matches.
+// A rule with no Services and no ResourceTypes matches all resources.
+type DefaultRule struct {
+	ExcludeKeys   []string     // Glob patterns for default tag keys that are not applied to matching resources
+	ResourceTypes []string     // Glob patterns for matching resource type names, e.g. "aws_db_*"
+	Services      []string     // Matching service package names, e.g. "rds"
+	Tags          KeyValueTags // Additional default tags applied to matching resources
+}
+
+// Match returns whether the rule matches resources of the specified type in the specified service package.
+func (r DefaultRule) Match(servicePackageName, typeName string) bool {
+	if len(r.Services) > 0 && !slices.Contains(r.Services, servicePackageName) {
+		return false
+	}
+
+	if len(r.ResourceTypes) > 0 && !matchAny(r.ResourceTypes, typeName) {
+		return false
+	}
+
+	return true
+}
+
+// Validate returns an error if any of the rule's glob patterns are malformed.
+func (r DefaultRule) Validate() error {
+	for _, pattern := range slices.Concat(r.ExcludeKeys, r.ResourceTypes) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern (%s): %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// ForResource returns the default tags configuration for resources of the specified type in the specified service package.
+// Matching rules are evaluated in order. Each removes any excluded keys from the default tags and then adds its own tags.
+// The returned configuration has no rules.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags.Merge(nil)
+
+	for _, rule := range dc.Rules {
+		if !rule.Match(servicePackageName, typeName) {
+			continue
+		}
+
+		for k := range tags {
+			if matchAny(rule.ExcludeKeys, k) {
+				delete(tags, k)
+			}
+		}
+
+		tags = tags.Merge(rule.Tags)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
+// matchAny returns whether the value matches any of the glob patterns.
+func matchAny(patterns []string, v string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, _ := path.Match(pattern, v)
+		return ok
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Rules: []DefaultRule{
+			{
+				Services: []string{"ec2", "efs", "rds"},
+				Tags: New(ctx, map[string]string{
+					"backup-policy": "daily",
+				}),
+			},
+			{
+				ResourceTypes: []string{"aws_db_parameter_group", "aws_ec2_*"},
+				ExcludeKeys:   []string{"backup-*"},
+			},
+			{
+				ResourceTypes: []string{"aws_iam_*"},
+				ExcludeKeys:   []string{"*"},
+			},
+			{
+				Services:      []string{"rds"},
+				ResourceTypes: []string{"aws_db_instance"},
+				Tags: New(ctx, map[string]string{
+					"owner": "dba",
+				}),
+			},
+		},
+		Tags: New(ctx, map[string]string{
+			"owner": "finops",
+		}),
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+		},
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:               "no matching rules",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want: map[string]string{
+				"owner": "finops",
+			},
+		},
+		{
+			name:               "service match",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ebs_volume",
+			want: map[string]string{
+				"backup-policy": "daily",
+				"owner":         "finops",
+			},
+		},
+		{
+			name:               "resource type glob exclude",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ec2_host",
+			want: map[string]string{
+				"owner": "finops",
+			},
+		},
+		{
+			name:               "resource type exclude",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "rds",
+			typeName:           "aws_db_parameter_group",
+			want: map[string]string{
+				"owner": "finops",
+			},
+		},
+		{
+			name:               "later rule overrides",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "rds",
+			typeName:           "aws_db_instance",
+			want: map[string]string{
+				"backup-policy": "daily",
+				"owner":         "dba",
+			},
+		},
+		{
+			name:               "all excluded",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil configuration, got %v", got.Tags.Map())
+				}
+				return
+			}
+
+			if len(got.Rules) > 0 {
+				t.Errorf("expected no rules, got %d", len(got.Rules))
+			}
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
@@ -50,8 +50,10 @@ const (
 )
 
 // DefaultConfig contains tags to default across all resources.
+// Any Rules scope the default tags to particular resources and are evaluated by ForResource.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Rules []DefaultRule
+	Tags  KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// organizationsTagPolicy is the content of an AWS Organizations effective tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax.html.
+type organizationsTagPolicy struct {
+	Tags map[string]organizationsTagPolicyTag `json:"tags"`
+}
+
+type organizationsTagPolicyTag struct {
+	EnforcedFor organizationsPolicyValue[[]string] `json:"enforced_for"`
+	TagKey      organizationsPolicyValue[string]   `json:"tag_key"`
+	TagValue    organizationsPolicyValue[[]string] `json:"tag_value"`
+}
+
+// organizationsPolicyValue is a policy value that may be wrapped in an `@@assign` operator.
+type organizationsPolicyValue[T any] struct {
+	Value T
+}
+
+func (v *organizationsPolicyValue[T]) UnmarshalJSON(b []byte) error {
+	var operators struct {
+		Assign *T `json:"@@assign"`
+	}
+	if err := json.Unmarshal(b, &operators); err == nil && operators.Assign != nil {
+		v.Value = *operators.Assign
+		return nil
+	}
+
+	return json.Unmarshal(b, &v.Value)
+}
+
+// OrganizationsPolicyRules returns tag policy rules equivalent to the specified AWS Organizations effective tag policy content.
+// A tag policy does not require tags to be present, but any tag whose key matches one in the policy must
+// use the policy's capitalization and, if the policy lists values, have one of those values.
+// AWS Organizations only prevents noncompliant tagging of the resource types that the policy enforces the tag for,
+// so violations for other resource types are warnings.
+func OrganizationsPolicyRules(content string, severity PolicySeverity) ([]PolicyRule, error) {
+	var policy organizationsTagPolicy
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	rules := make([]PolicyRule, 0, len(policy.Tags))
+
+	for _, name := range slices.Sorted(maps.Keys(policy.Tags)) {
+		tag := policy.Tags[name]
+
+		rule := PolicyRule{
+			EnforceKeyCase: true,
+			Key:            tag.TagKey.Value,
+			Optional:       true,
+			Severity:       severity,
+		}
+
+		if values := tag.EnforcedFor.Value; len(values) > 0 {
+			rule.EnforcedFor = values
+		} else {
+			// The tag isn't enforced for any resource type.
+			rule.Severity = PolicySeverityWarning
+		}
+
+		if rule.Key == "" {
+			// The policy doesn't specify capitalization, so the policy key is used.
+			rule.Key = name
+			rule.EnforceKeyCase = false
+		}
+
+		if values := tag.TagValue.Value; len(values) > 0 && !slices.Contains(values, "*") {
+			if slices.ContainsFunc(values, func(v string) bool { return strings.Contains(v, "*") }) {
+				rule.Pattern = organizationsTagValuesPattern(values)
+			} else {
+				rule.AllowedValues = values
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// organizationsTagValuesPattern returns a regular expression matching any of the specified tag values,
+// in which an asterisk matches any sequence of characters.
+// All other characters are quoted so the expression always compiles.
+func organizationsTagValuesPattern(values []string) *regexp.Regexp {
+	alternatives := make([]string, 0, len(values))
+
+	for _, v := range values {
+		parts := strings.Split(v, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		alternatives = append(alternatives, strings.Join(parts, ".*"))
+	}
+
+	return regexache.MustCompile(`^(?:` + strings.Join(alternatives, "|") + `)$`)
+}
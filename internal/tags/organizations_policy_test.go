@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsPolicyRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name      string
+		content   string
+		tags      map[string]string
+		want      []PolicyViolation
+		wantError bool
+	}{
+		{
+			name:      "invalid JSON",
+			content:   `{"tags":`,
+			wantError: true,
+		},
+		{
+			name:    "no tags",
+			content: `{}`,
+			tags: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:    "compliant",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100","200"]},"project":{"tag_key":"Project","tag_value":["*"]}}}`,
+			tags: map[string]string{
+				"CostCenter": "100",
+				"Project":    "anything",
+			},
+		},
+		{
+			name:    "optional",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100","200"]}}}`,
+			tags: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:    "key case",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter"}}}`,
+			tags: map[string]string{
+				"costcenter": "100",
+			},
+			want: []PolicyViolation{
+				{Key: "costcenter", Message: `must be written as "CostCenter"`, Severity: PolicySeverityWarning},
+			},
+		},
+		{
+			name:    "allowed values",
+			content: `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100","200"]}}}}`,
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			want: []PolicyViolation{
+				{Key: "CostCenter", Message: `has value "300", expected one of [100, 200]`, Severity: PolicySeverityWarning},
+			},
+		},
+		{
+			name:    "wildcard values",
+			content: `{"tags":{"project":{"tag_key":"Project","tag_value":["Alpha*","B.eta"]}}}`,
+			tags: map[string]string{
+				"Project": "Bxeta",
+			},
+			want: []PolicyViolation{
+				{Key: "Project", Message: `has value "Bxeta", which does not match "^(?:Alpha.*|B\\.eta)$"`, Severity: PolicySeverityWarning},
+			},
+		},
+		{
+			name:    "enforced for resource type",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100"],"enforced_for":{"@@assign":["ec2:instance"]}}}}`,
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			want: []PolicyViolation{
+				{Key: "CostCenter", Message: `has value "300", expected one of [100]`, Severity: PolicySeverityError},
+			},
+		},
+		{
+			name:    "enforced for all supported",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","enforced_for":["ec2:ALL_SUPPORTED"]}}}`,
+			tags: map[string]string{
+				"costcenter": "100",
+			},
+			want: []PolicyViolation{
+				{Key: "costcenter", Message: `must be written as "CostCenter"`, Severity: PolicySeverityError},
+			},
+		},
+		{
+			name:    "enforced for other resource types",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100"],"enforced_for":["ec2:volume","s3:bucket"]}}}`,
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			want: []PolicyViolation{
+				{Key: "CostCenter", Message: `has value "300", expected one of [100]`, Severity: PolicySeverityWarning},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := OrganizationsPolicyRules(testCase.content, PolicySeverityError)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("got error %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got := (&PolicyConfig{Rules: rules}).ForResource("ec2", "aws_instance").Evaluate(New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicySeverity is the severity of a tag policy violation.
+type PolicySeverity string
+
+const (
+	PolicySeverityError   PolicySeverity = "error"
+	PolicySeverityWarning PolicySeverity = "warning"
+)
+
+// Values returns all known PolicySeverity values.
+func (PolicySeverity) Values() []PolicySeverity {
+	return []PolicySeverity{
+		PolicySeverityError,
+		PolicySeverityWarning,
+	}
+}
+
+// PolicyRule requires that a tag key is present and, optionally, constrains its value.
+type PolicyRule struct {
+	AllowedValues  []string       // If not empty, the tag value must be one of these values
+	EnforceKeyCase bool           // If true, a tag key that differs from Key only in case is a violation
+	EnforcedFor    []string       // If not empty, AWS resource types ("service:type") outside of which violations are warnings
+	Key            string         // The required tag key
+	Optional       bool           // If true, the tag key need not be present
+	Pattern        *regexp.Regexp // If not nil, the tag value must match this regular expression
+	Severity       PolicySeverity // The severity of violations; defaults to PolicySeverityError
+}
+
+// PolicyConfig contains the provider's tag policy.
+type PolicyConfig struct {
+	Rules []PolicyRule
+}
+
+// PolicyViolation describes a tag which does not conform to a tag policy rule.
+type PolicyViolation struct {
+	Key      string
+	Message  string
+	Severity PolicySeverity
+}
+
+func (v PolicyViolation) String() string {
+	return fmt.Sprintf("tag %q %s", v.Key, v.Message)
+}
+
+// IsError returns whether the violation is an error rather than a warning.
+func (v PolicyViolation) IsError() bool {
+	return v.Severity != PolicySeverityWarning
+}
+
+// Evaluate returns any violations of the policy by the specified tags.
+func (pc *PolicyConfig) Evaluate(tags KeyValueTags) []PolicyViolation {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	for _, rule := range pc.Rules {
+		v, ok := tags[rule.Key]
+		if !ok {
+			if rule.EnforceKeyCase {
+				if k, ok := findKeyFold(tags, rule.Key); ok {
+					violations = append(violations, PolicyViolation{
+						Key:      k,
+						Message:  fmt.Sprintf("must be written as %q", rule.Key),
+						Severity: rule.Severity,
+					})
+					continue
+				}
+			}
+
+			if !rule.Optional {
+				violations = append(violations, PolicyViolation{
+					Key:      rule.Key,
+					Message:  "is required",
+					Severity: rule.Severity,
+				})
+			}
+			continue
+		}
+
+		value := v.ValueString()
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+			violations = append(violations, PolicyViolation{
+				Key:      rule.Key,
+				Message:  fmt.Sprintf("has value %q, expected one of [%s]", value, strings.Join(rule.AllowedValues, ", ")),
+				Severity: rule.Severity,
+			})
+			continue
+		}
+
+		if rule.Pattern != nil && !rule.Pattern.MatchString(value) {
+			violations = append(violations, PolicyViolation{
+				Key:      rule.Key,
+				Message:  fmt.Sprintf("has value %q, which does not match %q", value, rule.Pattern.String()),
+				Severity: rule.Severity,
+			})
+		}
+	}
+
+	return violations
+}
+
+// ForResource returns the tag policy configuration for resources of the specified type in the specified service package.
+// Rules that are not enforced for the resource have warning severity.
+func (pc *PolicyConfig) ForResource(servicePackageName, typeName string) *PolicyConfig {
+	if pc == nil {
+		return nil
+	}
+
+	rules := slices.Clone(pc.Rules)
+
+	for i, rule := range rules {
+		if len(rule.EnforcedFor) > 0 && !slices.ContainsFunc(rule.EnforcedFor, func(v string) bool {
+			return enforcedForResource(v, servicePackageName, typeName)
+		}) {
+			rules[i].Severity = PolicySeverityWarning
+		}
+	}
+
+	return &PolicyConfig{
+		Rules: rules,
+	}
+}
+
+// enforcedForResource returns whether the specified AWS resource type ("service:type") refers to resources of the
+// specified type in the specified service package.
+// The service must be the service package name. "ALL_SUPPORTED" matches every resource type in the service;
+// any other type matches Terraform resource type names ending in its snake case form, e.g. "ec2:security-group"
+// matches "aws_security_group".
+func enforcedForResource(resourceType, servicePackageName, typeName string) bool {
+	service, typ, ok := strings.Cut(resourceType, ":")
+	if !ok || service != servicePackageName {
+		return false
+	}
+
+	if typ == "ALL_SUPPORTED" {
+		return true
+	}
+
+	return strings.HasSuffix(typeName, "_"+strings.ToLower(strings.ReplaceAll(typ, "-", "_")))
+}
+
+// findKeyFold returns the first tag key, in sorted order, that is equal to the specified key under Unicode case-folding.
+func findKeyFold(tags KeyValueTags, key string) (string, bool) {
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigEvaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		Rules: []PolicyRule{
+			{
+				Key: "owner",
+			},
+			{
+				AllowedValues: []string{"dev", "prod"},
+				Key:           "environment",
+				Severity:      PolicySeverityWarning,
+			},
+			{
+				Key:     "cost-center",
+				Pattern: regexache.MustCompile(`^CC-[0-9]{4}$`),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         map[string]string
+		want         []PolicyViolation
+	}{
+		{
+			name: "nil config",
+			tags: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:         "compliant",
+			policyConfig: policyConfig,
+			tags: map[string]string{
+				"cost-center": "CC-1234",
+				"environment": "prod",
+				"owner":       "finops",
+			},
+		},
+		{
+			name:         "missing keys",
+			policyConfig: policyConfig,
+			tags: map[string]string{
+				"environment": "dev",
+			},
+			want: []PolicyViolation{
+				{Key: "owner", Message: "is required"},
+				{Key: "cost-center", Message: "is required"},
+			},
+		},
+		{
+			name:         "invalid values",
+			policyConfig: policyConfig,
+			tags: map[string]string{
+				"cost-center": "1234",
+				"environment": "test",
+				"owner":       "",
+			},
+			want: []PolicyViolation{
+				{Key: "environment", Message: `has value "test", expected one of [dev, prod]`, Severity: PolicySeverityWarning},
+				{Key: "cost-center", Message: `has value "1234", which does not match "^CC-[0-9]{4}$"`},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Evaluate(New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyViolationIsError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		severity PolicySeverity
+		want     bool
+	}{
+		{"", true},
+		{PolicySeverityError, true},
+		{PolicySeverityWarning, false},
+	}
+
+	for _, testCase := range testCases {
+		if got := (PolicyViolation{Severity: testCase.severity}).IsError(); got != testCase.want {
+			t.Errorf("severity %q: got %t, want %t", testCase.severity, got, testCase.want)
+		}
+	}
+}
+
+func TestPolicyConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	policyConfig := &PolicyConfig{
+		Rules: []PolicyRule{
+			{
+				Key: "owner",
+			},
+			{
+				EnforcedFor: []string{"ec2:security-group", "rds:ALL_SUPPORTED"},
+				Key:         "environment",
+			},
+		},
+	}
+
+	testCases := []struct {
+		servicePackageName string
+		typeName           string
+		want               []PolicySeverity
+	}{
+		{"ec2", "aws_security_group", []PolicySeverity{"", ""}},
+		{"ec2", "aws_instance", []PolicySeverity{"", PolicySeverityWarning}},
+		{"rds", "aws_db_instance", []PolicySeverity{"", ""}},
+		{"s3", "aws_s3_bucket", []PolicySeverity{"", PolicySeverityWarning}},
+	}
+
+	for _, testCase := range testCases {
+		got := policyConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+		for i, rule := range got.Rules {
+			if rule.Severity != testCase.want[i] {
+				t.Errorf("%s (%s) rule %q: got severity %q, want %q", testCase.typeName, testCase.servicePackageName, rule.Key, rule.Severity, testCase.want[i])
+			}
+		}
+	}
+
+	if policyConfig.Rules[1].Severity != "" {
+		t.Errorf("ForResource modified the policy configuration")
+	}
+}
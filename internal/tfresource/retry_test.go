@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 //nolint:tparallel
@@ -314,6 +315,31 @@ func TestRetryContext_error(t *testing.T) {
 	}
 }
 
+func TestRetry_vcrReplaying(t *testing.T) { //nolint:paralleltest
+	ctx := acctest.Context(t)
+
+	t.Setenv(vcr.EnvVarMode, vcr.ModeReplaying)
+	t.Setenv(vcr.EnvVarPath, t.TempDir())
+
+	var retryCount int32
+	f := func() *retry.RetryError {
+		if atomic.AddInt32(&retryCount, 1) < 5 {
+			return retry.RetryableError(errors.New("not yet"))
+		}
+
+		return nil
+	}
+
+	start := time.Now()
+	if err := tfresource.Retry(ctx, time.Minute, f, tfresource.WithDelay(time.Minute), tfresource.WithMinPollInterval(time.Minute)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waited %s, expected no delay when replaying", elapsed)
+	}
+}
+
 func TestOptionsApply(t *testing.T) {
 	t.Parallel()
 
@@ -5,9 +5,12 @@ package tfresource
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 type WaitOpts struct {
@@ -17,6 +20,11 @@ type WaitOpts struct {
 	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
 }
 
+const (
+	// replayPollInterval is the interval between refreshes when VCR is replaying recorded interactions.
+	replayPollInterval = 1 * time.Millisecond
+)
+
 const (
 	targetStateError = "ERROR"
 	targetStateFalse = "FALSE"
@@ -53,7 +61,42 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := WaitForStateContext(ctx, stateConf)
 
 	return err
 }
+
+// WaitForStateContext waits for the state described by `conf`, returning the result of the last refresh.
+// When VCR is replaying recorded interactions, `conf`'s delay and poll intervals are ignored.
+func WaitForStateContext(ctx context.Context, conf *retry.StateChangeConf) (interface{}, error) {
+	return waitForStateContext(ctx, "WaitForState", conf)
+}
+
+// waitForStateContext waits for the state described by `conf`, tracing the wait in a span with the specified name.
+func waitForStateContext(ctx context.Context, spanName string, conf *retry.StateChangeConf) (interface{}, error) {
+	if vcr.IsReplaying() {
+		conf.Delay = 0
+		conf.MinTimeout = 0
+		conf.PollInterval = replayPollInterval
+	}
+
+	ctx, span := tracing.StartWaiterSpan(ctx, spanName, tracing.String("tf_aws.wait.target", strings.Join(conf.Target, ",")))
+	defer span.End()
+
+	if span != nil {
+		c, refresh := *conf, conf.Refresh
+		c.Refresh = func() (interface{}, string, error) {
+			span.AddPoll()
+			return refresh()
+		}
+		conf = &c
+	}
+
+	output, err := conf.WaitForStateContext(ctx)
+
+	if err != nil {
+		span.SetError(err.Error())
+	}
+
+	return output, err
+}
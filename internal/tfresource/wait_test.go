@@ -9,8 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 func TestWaitUntil(t *testing.T) { //nolint:tparallel
@@ -70,3 +72,36 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitForStateContext_vcrReplaying(t *testing.T) { //nolint:paralleltest
+	ctx := acctest.Context(t)
+
+	t.Setenv(vcr.EnvVarMode, vcr.ModeReplaying)
+	t.Setenv(vcr.EnvVarPath, t.TempDir())
+
+	var refreshCount int32
+	conf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 5 {
+				return "", "pending", nil
+			}
+
+			return "", "done", nil
+		},
+		Timeout:      time.Minute,
+		Delay:        time.Minute,
+		MinTimeout:   time.Minute,
+		PollInterval: time.Minute,
+	}
+
+	start := time.Now()
+	if _, err := tfresource.WaitForStateContext(ctx, conf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waited %s, expected no delay when replaying", elapsed)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+const (
+	callMiddlewareID    = "TFAWS:Tracing:Call"
+	attemptMiddlewareID = "TFAWS:Tracing:Attempt"
+	retryMiddlewareID   = "Retry" // aws-sdk-go-v2/aws/retry.(*Attempt).ID().
+)
+
+// AddAPIMiddleware adds middleware to an AWS SDK for Go v2 API client's stack
+// that logs the outcome of each API operation and, if tracing is enabled, records a client span.
+func AddAPIMiddleware(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(callMiddleware(), middleware.After); err != nil {
+		return err
+	}
+
+	// Count each attempt made by the retryer.
+	if err := stack.Finalize.Insert(attemptMiddleware(), retryMiddlewareID, middleware.After); err != nil {
+		return stack.Finalize.Add(attemptMiddleware(), middleware.After)
+	}
+
+	return nil
+}
+
+type callStatsContextKey struct{}
+
+// callStats are the statistics of a single API call.
+type callStats struct {
+	attempts  int
+	throttles int
+}
+
+func callMiddleware() middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(callMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+		e := currentExporter()
+		var span *Span
+		if e != nil {
+			ctx, span = startSpan(ctx, e, serviceID+"."+operation, SpanKindClient,
+				String("rpc.system", "aws-api"),
+				String("rpc.service", serviceID),
+				String("rpc.method", operation),
+				String("cloud.region", awsmiddleware.GetRegion(ctx)),
+			)
+		}
+		stats := &callStats{}
+		ctx = context.WithValue(ctx, callStatsContextKey{}, stats)
+
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		duration := time.Since(start)
+
+		requestID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
+		logging.LogAPICall(ctx, logging.APICall{
+			Attempts:  stats.attempts,
+			Err:       err,
+			Latency:   duration,
+			RequestID: requestID,
+			Throttles: stats.throttles,
+		})
+
+		if e == nil {
+			return out, metadata, err
+		}
+
+		span.SetAttributes(
+			Int("tf_aws.attempts", int64(stats.attempts)),
+			Int("tf_aws.retries", int64(max(stats.attempts-1, 0))),
+			Int("tf_aws.throttles", int64(stats.throttles)),
+		)
+		if requestID != "" {
+			span.SetAttributes(String("aws.request_id", requestID))
+		}
+		if err != nil {
+			span.SetError(err.Error())
+		}
+		span.End()
+
+		e.recordAPICall(serviceID, operation, stats, err != nil, duration)
+
+		return out, metadata, err
+	})
+}
+
+func attemptMiddleware() middleware.FinalizeMiddleware {
+	throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	return middleware.FinalizeMiddlewareFunc(attemptMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		if stats, ok := ctx.Value(callStatsContextKey{}).(*callStats); ok {
+			stats.attempts++
+			if err != nil && throttles.IsErrorThrottle(err).Bool() {
+				stats.throttles++
+			}
+		}
+
+		return out, metadata, err
+	})
+}
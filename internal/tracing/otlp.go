@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+const (
+	instrumentationScope = "github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	serviceName          = "terraform-provider-aws"
+)
+
+// Attribute is a span attribute.
+type Attribute struct {
+	Key   string
+	Value any // One of string, int64, bool or float64.
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Float(key string, value float64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// The following types are the OTLP/JSON encoding of an ExportTraceServiceRequest.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+
+type otlpTracesData struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Kind              SpanKind       `json:"kind"`
+	Name              string         `json:"name"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	SpanID            string         `json:"spanId"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+	TraceID           string         `json:"traceId"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpStatusCodeError = 2
+)
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	IntValue    string   `json:"intValue,omitempty"` // 64-bit integers are encoded as decimal strings.
+	StringValue *string  `json:"stringValue,omitempty"`
+}
+
+func otlpAttributes(attributes []Attribute) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		kv := otlpKeyValue{Key: attribute.Key}
+
+		switch v := attribute.Value.(type) {
+		case bool:
+			kv.Value.BoolValue = &v
+		case float64:
+			kv.Value.DoubleValue = &v
+		case int64:
+			kv.Value.IntValue = strconv.FormatInt(v, 10)
+		case string:
+			kv.Value.StringValue = &v
+		default:
+			continue
+		}
+
+		kvs = append(kvs, kv)
+	}
+
+	return kvs
+}
+
+// marshalSpan returns the OTLP/JSON encoding of a completed span.
+func marshalSpan(s *Span) ([]byte, error) {
+	s.lock.Lock()
+	span := otlpSpan{
+		Attributes:        otlpAttributes(s.attributes),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Kind:              s.kind,
+		Name:              s.name,
+		ParentSpanID:      hex.EncodeToString(s.parentSpanID),
+		SpanID:            hex.EncodeToString(s.spanID),
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		TraceID:           hex.EncodeToString(s.traceID),
+	}
+	if s.statusError != "" {
+		span.Status = &otlpStatus{
+			Code:    otlpStatusCodeError,
+			Message: s.statusError,
+		}
+	}
+	s.lock.Unlock()
+
+	return json.Marshal(otlpTracesData{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttributes([]Attribute{
+						String("service.name", serviceName),
+						String("service.version", version.ProviderVersion),
+					}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{
+							Name:    instrumentationScope,
+							Version: version.ProviderVersion,
+						},
+						Spans: []otlpSpan{span},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+type operationKey struct {
+	operation, service string
+}
+
+// operationStats are the aggregate statistics of all calls to an API operation.
+type operationStats struct {
+	calls     int
+	duration  time.Duration
+	errors    int
+	retries   int
+	throttles int
+}
+
+func (e *exporter) recordAPICall(service, operation string, call *callStats, failed bool, duration time.Duration) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	key := operationKey{operation: operation, service: service}
+	stats, ok := e.stats[key]
+	if !ok {
+		stats = &operationStats{}
+		e.stats[key] = stats
+	}
+
+	stats.calls++
+	stats.duration += duration
+	if failed {
+		stats.errors++
+	}
+	stats.retries += max(call.attempts-1, 0)
+	stats.throttles += call.throttles
+}
+
+// summary returns a table of API calls by service and operation.
+func (e *exporter) summary() string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "Service\tOperation\tCalls\tRetries\tThrottles\tErrors\tTotal Time\t")
+
+	keys := slices.SortedFunc(maps.Keys(e.stats), func(a, b operationKey) int {
+		return cmp.Or(cmp.Compare(a.service, b.service), cmp.Compare(a.operation, b.operation))
+	})
+	for _, key := range keys {
+		stats := e.stats[key]
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%s\t\n", key.service, key.operation, stats.calls, stats.retries, stats.throttles, stats.errors, stats.duration.Round(time.Millisecond))
+	}
+
+	w.Flush()
+
+	return sb.String()
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing records opt-in traces of CRUD handlers and the AWS API calls that they make.
+// Spans are written as OTLP/JSON, one ExportTraceServiceRequest per line, to the file named by the
+// TF_AWS_TRACE_FILE environment variable. The file can be read by the OpenTelemetry Collector's
+// otlpjsonfile receiver.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	EnvVarFile = "TF_AWS_TRACE_FILE"
+)
+
+// SpanKind is the OTLP span kind.
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+var (
+	defaultExporter     *exporter
+	defaultExporterOnce sync.Once
+)
+
+// Enabled returns whether tracing is enabled.
+func Enabled() bool {
+	return os.Getenv(EnvVarFile) != ""
+}
+
+// currentExporter returns the exporter configured from the environment, or nil if tracing is disabled.
+func currentExporter() *exporter {
+	defaultExporterOnce.Do(func() {
+		path := os.Getenv(EnvVarFile)
+		if path == "" {
+			return
+		}
+
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			log.Printf("[WARN] Tracing disabled, opening trace file (%s): %s", path, err)
+			return
+		}
+
+		defaultExporter = newExporter(f)
+	})
+
+	return defaultExporter
+}
+
+// Shutdown closes the trace file and logs a summary of the AWS API calls made by the provider.
+// It is a no-op if tracing is disabled.
+func Shutdown() {
+	e := currentExporter()
+	if e == nil {
+		return
+	}
+
+	if err := e.close(); err != nil {
+		log.Printf("[WARN] Closing trace file: %s", err)
+	}
+
+	log.Printf("[INFO] AWS API calls by service and operation:\n%s", e.summary())
+}
+
+type spanContextKey struct{}
+
+// Span is an in-progress span. A nil Span is valid and records nothing.
+type Span struct {
+	attributes   []Attribute
+	end          time.Time
+	exporter     *exporter
+	kind         SpanKind
+	lock         sync.Mutex
+	name         string
+	parent       *Span
+	parentSpanID []byte
+	spanID       []byte
+	start        time.Time
+	statusError  string
+	traceID      []byte
+	waiter       bool
+
+	// Time spent in, and number of, API calls made by child client spans.
+	apiCallDuration time.Duration
+	apiCalls        int
+
+	// Number of refreshes made by a waiter span.
+	polls int
+
+	// Time spent in child waiter spans.
+	waitDuration time.Duration
+}
+
+// StartSpan starts a span which is a child of any span in the specified Context.
+// If tracing is disabled the Context is returned unchanged along with a nil Span.
+func StartSpan(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	return startSpan(ctx, currentExporter(), name, kind, attributes...)
+}
+
+func startSpan(ctx context.Context, e *exporter, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	if e == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		attributes: attributes,
+		exporter:   e,
+		kind:       kind,
+		name:       name,
+		spanID:     randomID(8),
+		start:      time.Now(),
+	}
+
+	if parent, ok := ctx.Value(spanContextKey{}).(*Span); ok {
+		span.parent = parent
+		span.parentSpanID = parent.spanID
+		span.traceID = parent.traceID
+	} else {
+		span.traceID = randomID(16)
+	}
+
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+// StartHandlerSpan starts a span for a resource or data source CRUD handler.
+func StartHandlerSpan(ctx context.Context, operation, servicePackageName, typeName string) (context.Context, *Span) {
+	return StartSpan(ctx, fmt.Sprintf("%s %s", operation, typeName), SpanKindInternal,
+		String("tf_aws.operation", operation),
+		String("tf_aws.service_package", servicePackageName),
+		String("tf_aws.type_name", typeName),
+	)
+}
+
+// StartWaiterSpan starts a span for a waiter or retry loop.
+// Each refresh is counted by calling AddPoll.
+func StartWaiterSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, *Span) {
+	return startWaiterSpan(ctx, currentExporter(), name, attributes...)
+}
+
+func startWaiterSpan(ctx context.Context, e *exporter, name string, attributes ...Attribute) (context.Context, *Span) {
+	ctx, span := startSpan(ctx, e, name, SpanKindInternal, attributes...)
+	if span != nil {
+		span.waiter = true
+	}
+
+	return ctx, span
+}
+
+// AddPoll counts a waiter span's refresh.
+func (s *Span) AddPoll() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.polls++
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.attributes = append(s.attributes, attributes...)
+}
+
+// SetError marks the span as failed.
+func (s *Span) SetError(message string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.statusError = message
+}
+
+// End completes the span and exports it.
+// A span with child client spans records the time spent in API calls, and a span with child
+// waiter spans records the time spent in waiters and retry loops.
+// A waiter span records its number of refreshes and elapsed time.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	s.end = time.Now()
+	duration := s.end.Sub(s.start)
+	if s.apiCalls > 0 {
+		s.attributes = append(s.attributes,
+			Int("tf_aws.api_calls", int64(s.apiCalls)),
+			Int("tf_aws.api_call_duration_ms", s.apiCallDuration.Milliseconds()),
+		)
+	}
+	if s.waitDuration > 0 {
+		s.attributes = append(s.attributes, Int("tf_aws.wait_duration_ms", s.waitDuration.Milliseconds()))
+	}
+	if s.waiter {
+		s.attributes = append(s.attributes,
+			Int("tf_aws.polls", int64(s.polls)),
+			Int("tf_aws.elapsed_ms", duration.Milliseconds()),
+		)
+	}
+	s.lock.Unlock()
+
+	if s.parent != nil {
+		switch {
+		case s.kind == SpanKindClient:
+			s.parent.addAPICall(duration)
+		case s.waiter:
+			s.parent.addWait(duration)
+		}
+	}
+
+	s.exporter.export(s)
+}
+
+func (s *Span) addAPICall(duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.apiCalls++
+	s.apiCallDuration += duration
+}
+
+func (s *Span) addWait(duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.waitDuration += duration
+}
+
+// exporter writes completed spans to a file and aggregates API call statistics.
+type exporter struct {
+	lock  sync.Mutex
+	stats map[operationKey]*operationStats
+	w     io.Writer
+}
+
+func newExporter(w io.Writer) *exporter {
+	return &exporter{
+		stats: make(map[operationKey]*operationStats),
+		w:     w,
+	}
+}
+
+func (e *exporter) export(s *Span) {
+	b, err := marshalSpan(s)
+	if err != nil {
+		log.Printf("[WARN] Encoding trace span (%s): %s", s.name, err)
+		return
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if _, err := e.w.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] Writing trace span (%s): %s", s.name, err)
+	}
+}
+
+func (e *exporter) close() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if v, ok := e.w.(io.Closer); ok {
+		return v.Close()
+	}
+
+	return nil
+}
+
+func randomID(n int) []byte {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return b
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSpanExport(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	e := newExporter(&buf)
+
+	ctx, handler := startSpan(context.Background(), e, "Create aws_example_thing", SpanKindInternal, String("tf_aws.type_name", "aws_example_thing"))
+	_, call := startSpan(ctx, e, "Example.CreateThing", SpanKindClient, Int("tf_aws.attempts", 2), Bool("ok", false), Float("ratio", 0.5))
+	call.SetError("throttled")
+	call.End()
+	handler.End()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("exported %d spans, want %d", got, want)
+	}
+
+	var spans []otlpSpan
+	for _, line := range lines {
+		var v otlpTracesData
+		if err := json.Unmarshal([]byte(line), &v); err != nil {
+			t.Fatalf("decoding span: %s", err)
+		}
+		if got, want := *v.ResourceSpans[0].Resource.Attributes[0].Value.StringValue, serviceName; got != want {
+			t.Errorf("service.name: got %q, want %q", got, want)
+		}
+		spans = append(spans, v.ResourceSpans[0].ScopeSpans[0].Spans...)
+	}
+
+	child, parent := spans[0], spans[1]
+	if got, want := child.TraceID, parent.TraceID; got != want || len(got) != 32 {
+		t.Errorf("child trace ID: got %q, want %q", got, want)
+	}
+	if got, want := child.ParentSpanID, parent.SpanID; got != want || len(got) != 16 {
+		t.Errorf("child parent span ID: got %q, want %q", got, want)
+	}
+	if parent.ParentSpanID != "" {
+		t.Errorf("root span has parent span ID %q", parent.ParentSpanID)
+	}
+	if child.Status == nil || child.Status.Code != otlpStatusCodeError || child.Status.Message != "throttled" {
+		t.Errorf("child status: got %+v", child.Status)
+	}
+	if got, want := child.Attributes[0].Value.IntValue, "2"; got != want {
+		t.Errorf("child attempts: got %q, want %q", got, want)
+	}
+
+	attributes := make(map[string]otlpAnyValue)
+	for _, v := range parent.Attributes {
+		attributes[v.Key] = v.Value
+	}
+	if got, want := attributes["tf_aws.api_calls"].IntValue, "1"; got != want {
+		t.Errorf("tf_aws.api_calls: got %q, want %q", got, want)
+	}
+	if _, ok := attributes["tf_aws.api_call_duration_ms"]; !ok {
+		t.Error("missing attribute tf_aws.api_call_duration_ms")
+	}
+	if _, ok := attributes["tf_aws.wait_duration_ms"]; ok {
+		t.Error("unexpected attribute tf_aws.wait_duration_ms for handler without waiters")
+	}
+}
+
+func TestWaiterSpan(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	e := newExporter(&buf)
+
+	ctx, handler := startSpan(context.Background(), e, "Create aws_example_thing", SpanKindInternal)
+	ctx, waiter := startWaiterSpan(ctx, e, "WaitForState", String("tf_aws.wait.target", "available"))
+	for range 3 {
+		waiter.AddPoll()
+	}
+	_, retry := startWaiterSpan(ctx, e, "Retry")
+	retry.AddPoll()
+	time.Sleep(2 * time.Millisecond)
+	retry.End()
+	time.Sleep(2 * time.Millisecond)
+	waiter.End()
+	handler.End()
+
+	spans := make(map[string]map[string]otlpAnyValue)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var v otlpTracesData
+		if err := json.Unmarshal([]byte(line), &v); err != nil {
+			t.Fatalf("decoding span: %s", err)
+		}
+		span := v.ResourceSpans[0].ScopeSpans[0].Spans[0]
+		attributes := make(map[string]otlpAnyValue)
+		for _, v := range span.Attributes {
+			attributes[v.Key] = v.Value
+		}
+		spans[span.Name] = attributes
+	}
+
+	if got, want := spans["WaitForState"]["tf_aws.polls"].IntValue, "3"; got != want {
+		t.Errorf("WaitForState tf_aws.polls: got %q, want %q", got, want)
+	}
+	if got, want := spans["Retry"]["tf_aws.polls"].IntValue, "1"; got != want {
+		t.Errorf("Retry tf_aws.polls: got %q, want %q", got, want)
+	}
+	// Time in nested waiters is counted once, by the outermost waiter.
+	if got, want := spans["Create aws_example_thing"]["tf_aws.wait_duration_ms"].IntValue, spans["WaitForState"]["tf_aws.elapsed_ms"].IntValue; got != want || got == "" {
+		t.Errorf("handler tf_aws.wait_duration_ms: got %q, want %q", got, want)
+	}
+	if _, ok := spans["Create aws_example_thing"]["tf_aws.polls"]; ok {
+		t.Error("unexpected attribute tf_aws.polls for handler")
+	}
+}
+
+func TestSpanDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got, span := startSpan(ctx, nil, "Read aws_example_thing", SpanKindInternal)
+
+	if got != ctx {
+		t.Error("expected Context to be unchanged")
+	}
+	if span != nil {
+		t.Fatal("expected nil Span")
+	}
+
+	// A nil Span records nothing.
+	span.SetAttributes(String("key", "value"))
+	span.SetError("error")
+	span.End()
+}
+
+func TestExporterSummary(t *testing.T) {
+	t.Parallel()
+
+	e := newExporter(&bytes.Buffer{})
+	e.recordAPICall("S3", "PutObject", &callStats{attempts: 1}, false, 100*time.Millisecond)
+	e.recordAPICall("IAM", "CreateRole", &callStats{attempts: 3, throttles: 2}, false, time.Second)
+	e.recordAPICall("IAM", "CreateRole", &callStats{attempts: 1}, true, 500*time.Millisecond)
+
+	want := `Service  Operation  Calls  Retries  Throttles  Errors  Total Time
+IAM      CreateRole  2      2        2          1       1.5s
+S3       PutObject   1      0        0          0       100ms
+`
+	got := strings.Join(strings.Fields(strings.ReplaceAll(e.summary(), "\n", " \n ")), " ")
+	want = strings.Join(strings.Fields(strings.ReplaceAll(want, "\n", " \n ")), " ")
+	if got != want {
+		t.Errorf("summary:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
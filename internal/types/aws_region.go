@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// IsAWSRegion returns whether or not the specified string is a valid AWS Region code.
+func IsAWSRegion(s string) bool { // nosemgrep:ci.aws-in-func-name
+	return regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`).MatchString(s)
+}
+
+// SplitRegionSuffix splits a string of the form `<id>@<region>` into its ID and AWS Region parts.
+// Returns false if the string does not end with a valid AWS Region code suffix.
+func SplitRegionSuffix(s string) (string, string, bool) {
+	i := strings.LastIndex(s, "@")
+	if i < 0 {
+		return s, "", false
+	}
+
+	if region := s[i+1:]; IsAWSRegion(region) {
+		return s[:i], region, true
+	}
+
+	return s, "", false
+}
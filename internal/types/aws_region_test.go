@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsAWSRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	for _, tc := range []struct {
+		region string
+		valid  bool
+	}{
+		{"us-west-2", true},      //lintignore:AWSAT003
+		{"us-gov-west-1", true},  //lintignore:AWSAT003
+		{"cn-northwest-1", true}, //lintignore:AWSAT003
+		{"us-west", false},
+		{"", false},
+		{"US-WEST-2", false},
+	} {
+		ok := IsAWSRegion(tc.region)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsAWSRegion(%q) = %v, want %v", tc.region, got, want)
+		}
+	}
+}
+
+func TestSplitRegionSuffix(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		input      string
+		wantID     string
+		wantRegion string
+		wantOK     bool
+	}{
+		{"vpc-12345678", "vpc-12345678", "", false},
+		{"vpc-12345678@us-west-2", "vpc-12345678", "us-west-2", true}, //lintignore:AWSAT003
+		{"user@example.com", "user@example.com", "", false},
+		{"user@example.com@eu-west-1", "user@example.com", "eu-west-1", true}, //lintignore:AWSAT003
+		{"", "", "", false},
+	} {
+		id, region, ok := SplitRegionSuffix(tc.input)
+		if got, want := id, tc.wantID; got != want {
+			t.Errorf("SplitRegionSuffix(%q) id = %v, want %v", tc.input, got, want)
+		}
+		if got, want := region, tc.wantRegion; got != want {
+			t.Errorf("SplitRegionSuffix(%q) region = %v, want %v", tc.input, got, want)
+		}
+		if got, want := ok, tc.wantOK; got != want {
+			t.Errorf("SplitRegionSuffix(%q) ok = %v, want %v", tc.input, got, want)
+		}
+	}
+}
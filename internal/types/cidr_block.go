@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	"net"
+	"net/netip"
 )
 
 // ValidateCIDRBlock validates that the specified CIDR block is valid:
@@ -52,3 +53,24 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks overlap.
+// CIDR blocks of different address families never overlap.
+func CIDRBlocksOverlap(cidr1, cidr2 string) (bool, error) {
+	for _, v := range []string{cidr1, cidr2} {
+		if err := ValidateCIDRBlock(v); err != nil {
+			return false, err
+		}
+	}
+
+	prefix1, err := netip.ParsePrefix(cidr1)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %w", cidr1, err)
+	}
+	prefix2, err := netip.ParsePrefix(cidr2)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %w", cidr2, err)
+	}
+
+	return prefix1.Overlaps(prefix2), nil
+}
@@ -72,3 +72,37 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1    string
+		cidr2    string
+		overlap  bool
+		hasError bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true, false},
+		{"10.0.1.0/24", "10.0.0.0/16", true, false},
+		{"10.0.0.0/24", "10.0.1.0/24", false, false},
+		{"10.0.0.0/8", "10.0.0.0/8", true, false},
+		{"2001:db8::/32", "2001:db8:1::/48", true, false},
+		{"2001:db8::/32", "2001:db9::/32", false, false},
+		{"10.0.0.0/8", "::/0", false, false},
+		{"10.0.0.0/1234", "10.0.0.0/8", false, true},
+		{"", "10.0.0.0/8", false, true},
+		{"10.0.0.1/8", "10.0.0.0/8", false, true},
+		{"10.0.0.0/8", "10.0.1.0/16", false, true},
+	} {
+		overlap, err := CIDRBlocksOverlap(ts.cidr1, ts.cidr2)
+		if ts.hasError && err == nil {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should error but didn't", ts.cidr1, ts.cidr2)
+		}
+		if !ts.hasError && err != nil {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) got unexpected error: %s", ts.cidr1, ts.cidr2, err)
+		}
+		if ts.overlap != overlap {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.overlap)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2199
+)
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	weekdayNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+)
+
+// cronSchedule is a parsed cron expression.
+// Day-of-week values are 1 (Sunday) to 7 (Saturday).
+type cronSchedule struct {
+	seconds []bool
+	minutes []bool
+	hours   []bool
+	months  []bool
+	years   []bool
+
+	dom cronDayOfMonth
+	dow cronDayOfWeek
+}
+
+type cronDayOfMonth struct {
+	none           bool   // ?
+	days           []bool // Explicit days, or * for all days.
+	last           bool   // L
+	lastWeekday    bool   // LW
+	nearestWeekday int    // nW
+}
+
+type cronDayOfWeek struct {
+	none    bool   // ?
+	days    []bool // Explicit days, or * for all days.
+	last    int    // nL: last given weekday of the month.
+	nth     int    // n#k: k-th given weekday of the month.
+	nthWeek int
+}
+
+// parseCron parses the body of a cron(...) expression:
+// `[seconds] minutes hours day-of-month month day-of-week year`.
+func parseCron(body string, allowSeconds bool) (*Expression, error) {
+	fields := strings.Fields(body)
+
+	var errs Errors
+	s := cronSchedule{
+		seconds: bits(0, 59),
+	}
+	s.seconds[0] = true
+
+	switch n := len(fields); {
+	case n == 7 && allowSeconds:
+		s.seconds, errs = parseCronField(errs, FieldSeconds, fields[0], 0, 59, nil)
+		fields = fields[1:]
+	case n != 6:
+		want := "6"
+		if allowSeconds {
+			want = "6 or 7"
+		}
+		return nil, Errors{{Field: FieldExpression, Message: fmt.Sprintf("must have %s space-separated fields, got %d", want, n)}}
+	}
+
+	s.minutes, errs = parseCronField(errs, FieldMinutes, fields[0], 0, 59, nil)
+	s.hours, errs = parseCronField(errs, FieldHours, fields[1], 0, 23, nil)
+	s.dom, errs = parseCronDayOfMonth(errs, fields[2])
+	s.months, errs = parseCronField(errs, FieldMonth, fields[3], 1, 12, monthNames)
+	s.dow, errs = parseCronDayOfWeek(errs, fields[4])
+	s.years, errs = parseCronField(errs, FieldYear, fields[5], cronMinYear, cronMaxYear, nil)
+
+	if s.dom.none == s.dow.none {
+		errs = append(errs, FieldError{Field: FieldDayOfWeek, Message: "exactly one of day-of-month or day-of-week must be ?"})
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return &Expression{
+		cron: &s,
+		typ:  TypeCron,
+	}, nil
+}
+
+func parseCronDayOfMonth(errs Errors, v string) (cronDayOfMonth, Errors) {
+	var dom cronDayOfMonth
+
+	switch {
+	case v == "?":
+		dom.none = true
+	case v == "L":
+		dom.last = true
+	case v == "LW":
+		dom.lastWeekday = true
+	case strings.HasSuffix(v, "W"):
+		n, err := strconv.Atoi(strings.TrimSuffix(v, "W"))
+		if err != nil || n < 1 || n > 31 {
+			return dom, append(errs, FieldError{Field: FieldDayOfMonth, Message: fmt.Sprintf("%q must be nW where n is between 1 and 31", v)})
+		}
+		dom.nearestWeekday = n
+	default:
+		dom.days, errs = parseCronField(errs, FieldDayOfMonth, v, 1, 31, nil)
+	}
+
+	return dom, errs
+}
+
+func parseCronDayOfWeek(errs Errors, v string) (cronDayOfWeek, Errors) {
+	var dow cronDayOfWeek
+
+	switch {
+	case v == "?":
+		dow.none = true
+	case v == "L":
+		// L on its own is the last day of the week, Saturday.
+		dow.days = bits(1, 7)
+		dow.days[6] = true
+	case strings.HasSuffix(v, "L"):
+		n, ok := parseCronValue(strings.TrimSuffix(v, "L"), 1, 7, weekdayNames)
+		if !ok {
+			return dow, append(errs, FieldError{Field: FieldDayOfWeek, Message: fmt.Sprintf("%q must be nL where n is a day of the week", v)})
+		}
+		dow.last = n
+	case strings.Contains(v, "#"):
+		day, week, _ := strings.Cut(v, "#")
+		n, ok := parseCronValue(day, 1, 7, weekdayNames)
+		k, err := strconv.Atoi(week)
+		if !ok || err != nil || k < 1 || k > 5 {
+			return dow, append(errs, FieldError{Field: FieldDayOfWeek, Message: fmt.Sprintf("%q must be n#k where n is a day of the week and k is between 1 and 5", v)})
+		}
+		dow.nth, dow.nthWeek = n, k
+	default:
+		dow.days, errs = parseCronField(errs, FieldDayOfWeek, v, 1, 7, weekdayNames)
+	}
+
+	return dow, errs
+}
+
+// parseCronField parses a comma-separated list of `*`, `n`, `n-m` and `n/k`, `*/k` or `n-m/k` increments.
+// The returned slice is indexed by value - lo.
+// Any error is appended to errs.
+func parseCronField(errs Errors, field, v string, lo, hi int, names map[string]int) ([]bool, Errors) {
+	values := bits(lo, hi)
+
+	for _, part := range strings.Split(v, ",") {
+		spec, step, hasStep := strings.Cut(part, "/")
+
+		increment := 1
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 || n > hi-lo+1 {
+				return nil, append(errs, FieldError{Field: field, Message: fmt.Sprintf("%q has an invalid increment, must be between 1 and %d", part, hi-lo+1)})
+			}
+			increment = n
+		}
+
+		var from, to int
+		switch first, last, isRange := strings.Cut(spec, "-"); {
+		case spec == "*":
+			from, to = lo, hi
+		case isRange:
+			var ok1, ok2 bool
+			from, ok1 = parseCronValue(first, lo, hi, names)
+			to, ok2 = parseCronValue(last, lo, hi, names)
+			if !ok1 || !ok2 {
+				return nil, append(errs, FieldError{Field: field, Message: fmt.Sprintf("%q is not a valid range, values must be between %d and %d", part, lo, hi)})
+			}
+		default:
+			var ok bool
+			from, ok = parseCronValue(spec, lo, hi, names)
+			if !ok {
+				return nil, append(errs, FieldError{Field: field, Message: fmt.Sprintf("%q is not valid, values must be between %d and %d", part, lo, hi)})
+			}
+			to = from
+			if hasStep {
+				to = hi
+			}
+		}
+
+		// Ranges such as FRI-MON wrap around.
+		for i, n := from, 0; ; n++ {
+			if n%increment == 0 {
+				values[i-lo] = true
+			}
+			if i == to {
+				break
+			}
+			if i++; i > hi {
+				i = lo
+			}
+		}
+	}
+
+	return values, errs
+}
+
+func parseCronValue(v string, lo, hi int, names map[string]int) (int, bool) {
+	if n, ok := names[strings.ToUpper(v)]; ok {
+		return n, true
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < lo || n > hi {
+		return 0, false
+	}
+
+	return n, true
+}
+
+func bits(lo, hi int) []bool {
+	return make([]bool, hi-lo+1)
+}
+
+func (s *cronSchedule) matchDay(t time.Time) bool {
+	if s.dom.none {
+		return s.dow.match(t)
+	}
+
+	return s.dom.match(t)
+}
+
+func (dom cronDayOfMonth) match(t time.Time) bool {
+	day, last := t.Day(), daysIn(t.Year(), t.Month())
+
+	switch {
+	case dom.last:
+		return day == last
+	case dom.lastWeekday:
+		return day == nearestWeekday(t.Year(), t.Month(), last)
+	case dom.nearestWeekday > 0:
+		return dom.nearestWeekday <= last && day == nearestWeekday(t.Year(), t.Month(), dom.nearestWeekday)
+	default:
+		return dom.days[day-1]
+	}
+}
+
+func (dow cronDayOfWeek) match(t time.Time) bool {
+	wd := int(t.Weekday()) + 1
+
+	switch {
+	case dow.last > 0:
+		return wd == dow.last && t.Day()+7 > daysIn(t.Year(), t.Month())
+	case dow.nth > 0:
+		return wd == dow.nth && (t.Day()-1)/7+1 == dow.nthWeek
+	default:
+		return dow.days[wd-1]
+	}
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to the specified day without leaving the month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysIn(year, month) {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func (s *cronSchedule) next(start time.Time, loc *time.Location, n int) []time.Time {
+	var times []time.Time
+	if n < 1 {
+		return times
+	}
+
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	for day.Year() <= cronMaxYear {
+		year, month := day.Year(), day.Month()
+
+		if year < cronMinYear || !s.years[year-cronMinYear] {
+			day = time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.months[month-1] {
+			day = time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if s.matchDay(day) {
+			for hour, ok := range s.hours {
+				if !ok {
+					continue
+				}
+				for minute, ok := range s.minutes {
+					if !ok {
+						continue
+					}
+					for second, ok := range s.seconds {
+						if !ok {
+							continue
+						}
+
+						t := time.Date(year, month, day.Day(), hour, minute, second, 0, loc)
+						// Skip times that don't exist locally.
+						if t.Hour() != hour || t.Minute() != minute {
+							continue
+						}
+						if !t.After(start) || (len(times) > 0 && !t.After(times[len(times)-1])) {
+							continue
+						}
+
+						if times = append(times, t); len(times) == n {
+							return times
+						}
+					}
+				}
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return times
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schedule parses the `at(...)`, `cron(...)` and `rate(...)` schedule expressions used by
+// Amazon EventBridge, EventBridge Scheduler, AWS Backup, Amazon Data Lifecycle Manager and AWS Systems Manager.
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+package schedule
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Type is the type of a schedule expression.
+type Type string
+
+const (
+	TypeAt   Type = "at"
+	TypeCron Type = "cron"
+	TypeRate Type = "rate"
+)
+
+func (Type) Values() []Type {
+	return []Type{
+		TypeAt,
+		TypeCron,
+		TypeRate,
+	}
+}
+
+// Field names used in errors.
+const (
+	FieldDayOfMonth = "day-of-month"
+	FieldDayOfWeek  = "day-of-week"
+	FieldExpression = "expression"
+	FieldHours      = "hours"
+	FieldMinutes    = "minutes"
+	FieldMonth      = "month"
+	FieldSeconds    = "seconds"
+	FieldUnit       = "unit"
+	FieldValue      = "value"
+	FieldYear       = "year"
+)
+
+// FieldError is an error in one part of a schedule expression.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Errors is the list of errors in a schedule expression.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	s := make([]string, len(e))
+	for i, v := range e {
+		s[i] = v.Error()
+	}
+
+	return strings.Join(s, "; ")
+}
+
+type options struct {
+	cronSeconds bool
+	types       []Type
+}
+
+// Option configures Parse.
+type Option func(*options)
+
+// WithTypes restricts the expression types that are accepted. By default all types are accepted.
+func WithTypes(types ...Type) Option {
+	return func(o *options) {
+		o.types = types
+	}
+}
+
+// WithCronSeconds accepts cron expressions with a leading seconds field, as used by AWS Systems Manager.
+func WithCronSeconds() Option {
+	return func(o *options) {
+		o.cronSeconds = true
+	}
+}
+
+var (
+	atRegexp         = regexache.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}$`)
+	expressionRegexp = regexache.MustCompile(`^([a-z]+)\((.*)\)$`)
+	rateRegexp       = regexache.MustCompile(`^(\S+)\s+(\S+)$`)
+)
+
+const (
+	atLayout = "2006-01-02T15:04:05"
+)
+
+// Expression is a parsed schedule expression.
+type Expression struct {
+	at   time.Time // Wall clock time, in UTC.
+	cron *cronSchedule
+	rate time.Duration
+	typ  Type
+}
+
+// Parse parses a schedule expression.
+// Any error is of type Errors.
+func Parse(s string, optFns ...Option) (*Expression, error) {
+	opts := options{
+		types: TypeAt.Values(),
+	}
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	m := expressionRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || !slices.Contains(opts.types, Type(m[1])) {
+		forms := make([]string, len(opts.types))
+		for i, v := range opts.types {
+			forms[i] = fmt.Sprintf("%s(...)", v)
+		}
+
+		return nil, Errors{{Field: FieldExpression, Message: fmt.Sprintf("must be one of %s", strings.Join(forms, ", "))}}
+	}
+
+	switch typ, body := Type(m[1]), strings.TrimSpace(m[2]); typ {
+	case TypeAt:
+		return parseAt(body)
+	case TypeCron:
+		return parseCron(body, opts.cronSeconds)
+	default:
+		return parseRate(body)
+	}
+}
+
+func parseAt(body string) (*Expression, error) {
+	if !atRegexp.MatchString(body) {
+		return nil, Errors{{Field: FieldExpression, Message: "must be at(yyyy-mm-ddThh:mm:ss)"}}
+	}
+
+	t, err := time.Parse(atLayout, body)
+	if err != nil {
+		return nil, Errors{{Field: FieldExpression, Message: fmt.Sprintf("invalid date and time %q", body)}}
+	}
+
+	return &Expression{
+		at:  t,
+		typ: TypeAt,
+	}, nil
+}
+
+func parseRate(body string) (*Expression, error) {
+	m := rateRegexp.FindStringSubmatch(body)
+	if m == nil {
+		return nil, Errors{{Field: FieldExpression, Message: "must be rate(value unit)"}}
+	}
+
+	var errs Errors
+
+	value, err := strconv.Atoi(m[1])
+	if err != nil || value < 1 {
+		errs = append(errs, FieldError{Field: FieldValue, Message: fmt.Sprintf("%q must be a positive integer", m[1])})
+	}
+
+	var unit time.Duration
+	switch m[2] {
+	case "minute", "minutes":
+		unit = time.Minute
+	case "hour", "hours":
+		unit = time.Hour
+	case "day", "days":
+		unit = 24 * time.Hour
+	default:
+		errs = append(errs, FieldError{Field: FieldUnit, Message: fmt.Sprintf("%q must be one of minute, minutes, hour, hours, day, days", m[2])})
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	if plural := strings.HasSuffix(m[2], "s"); value == 1 && plural {
+		return nil, Errors{{Field: FieldUnit, Message: fmt.Sprintf("%q must be singular when the value is 1", m[2])}}
+	} else if value > 1 && !plural {
+		return nil, Errors{{Field: FieldUnit, Message: fmt.Sprintf("%q must be plural when the value is greater than 1", m[2])}}
+	}
+
+	return &Expression{
+		rate: time.Duration(value) * unit,
+		typ:  TypeRate,
+	}, nil
+}
+
+// Type returns the expression's type.
+func (e *Expression) Type() Type {
+	return e.typ
+}
+
+// Next returns up to n times, after start, at which the schedule fires in the specified location.
+// Local times that do not exist because of a daylight saving time transition are skipped.
+func (e *Expression) Next(start time.Time, loc *time.Location, n int) []time.Time {
+	start = start.In(loc)
+
+	switch e.typ {
+	case TypeAt:
+		t := time.Date(e.at.Year(), e.at.Month(), e.at.Day(), e.at.Hour(), e.at.Minute(), e.at.Second(), 0, loc)
+		if n > 0 && t.After(start) {
+			return []time.Time{t}
+		}
+		return nil
+	case TypeCron:
+		return e.cron.next(start, loc, n)
+	default:
+		times := make([]time.Time, 0, n)
+		for i := 1; i <= n; i++ {
+			times = append(times, start.Add(time.Duration(i)*e.rate))
+		}
+		return times
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input          string
+		options        []Option
+		expectedType   Type
+		expectedFields []string
+	}{
+		// Invalid
+		"empty": {
+			input:          "",
+			expectedFields: []string{FieldExpression},
+		},
+		"unknown type": {
+			input:          "every(5 minutes)",
+			expectedFields: []string{FieldExpression},
+		},
+		"type not allowed": {
+			input:          "at(2025-01-01T00:00:00)",
+			options:        []Option{WithTypes(TypeCron, TypeRate)},
+			expectedFields: []string{FieldExpression},
+		},
+		"at bad format": {
+			input:          "at(2025-01-01 00:00:00)",
+			expectedFields: []string{FieldExpression},
+		},
+		"at bad date": {
+			input:          "at(2025-02-30T00:00:00)",
+			expectedFields: []string{FieldExpression},
+		},
+		"rate zero": {
+			input:          "rate(0 minutes)",
+			expectedFields: []string{FieldValue},
+		},
+		"rate bad unit": {
+			input:          "rate(5 seconds)",
+			expectedFields: []string{FieldUnit},
+		},
+		"rate bad value and unit": {
+			input:          "rate(x weeks)",
+			expectedFields: []string{FieldValue, FieldUnit},
+		},
+		"rate plural one": {
+			input:          "rate(1 hours)",
+			expectedFields: []string{FieldUnit},
+		},
+		"rate singular many": {
+			input:          "rate(5 minute)",
+			expectedFields: []string{FieldUnit},
+		},
+		"cron 5 fields": {
+			input:          "cron(0 12 * * ?)",
+			expectedFields: []string{FieldExpression},
+		},
+		"cron seconds not allowed": {
+			input:          "cron(0 0 12 * * ? *)",
+			expectedFields: []string{FieldExpression},
+		},
+		"cron bad minutes and hours": {
+			input:          "cron(60 24 * * ? *)",
+			expectedFields: []string{FieldMinutes, FieldHours},
+		},
+		"cron both days": {
+			input:          "cron(0 12 * * MON *)",
+			expectedFields: []string{FieldDayOfWeek},
+		},
+		"cron neither day": {
+			input:          "cron(0 12 ? * ? *)",
+			expectedFields: []string{FieldDayOfWeek},
+		},
+		"cron bad month": {
+			input:          "cron(0 12 1 FOO ? *)",
+			expectedFields: []string{FieldMonth},
+		},
+		"cron bad year": {
+			input:          "cron(0 12 1 * ? 1969)",
+			expectedFields: []string{FieldYear},
+		},
+		"cron bad increment": {
+			input:          "cron(0/0 12 1 * ? *)",
+			expectedFields: []string{FieldMinutes},
+		},
+		"cron bad nth": {
+			input:          "cron(0 12 ? * MON#6 *)",
+			expectedFields: []string{FieldDayOfWeek},
+		},
+		"cron bad nearest weekday": {
+			input:          "cron(0 12 32W * ? *)",
+			expectedFields: []string{FieldDayOfMonth},
+		},
+
+		// Valid
+		"at": {
+			input:        "at(2025-01-01T00:00:00)",
+			expectedType: TypeAt,
+		},
+		"rate singular": {
+			input:        "rate(1 day)",
+			expectedType: TypeRate,
+		},
+		"rate plural": {
+			input:        "rate(15 minutes)",
+			expectedType: TypeRate,
+		},
+		"cron": {
+			input:        "cron(0/15 8-17 ? * MON-FRI *)",
+			expectedType: TypeCron,
+		},
+		"cron names": {
+			input:        "cron(0 12 ? JAN,jul SUN 2025-2030)",
+			expectedType: TypeCron,
+		},
+		"cron last": {
+			input:        "cron(0 0 L * ? *)",
+			expectedType: TypeCron,
+		},
+		"cron last weekday": {
+			input:        "cron(0 0 LW * ? *)",
+			expectedType: TypeCron,
+		},
+		"cron nearest weekday": {
+			input:        "cron(0 0 15W * ? *)",
+			expectedType: TypeCron,
+		},
+		"cron nth": {
+			input:        "cron(0 0 ? * 3#2 *)",
+			expectedType: TypeCron,
+		},
+		"cron last friday": {
+			input:        "cron(0 0 ? * 6L *)",
+			expectedType: TypeCron,
+		},
+		"cron wrapped range": {
+			input:        "cron(0 0 ? * FRI-MON *)",
+			expectedType: TypeCron,
+		},
+		"cron seconds": {
+			input:        "cron(30 0 12 * * ? *)",
+			options:      []Option{WithCronSeconds()},
+			expectedType: TypeCron,
+		},
+		"cron seconds optional": {
+			input:        "cron(0 12 * * ? *)",
+			options:      []Option{WithCronSeconds()},
+			expectedType: TypeCron,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Parse(tc.input, tc.options...)
+
+			if len(tc.expectedFields) > 0 {
+				var errs Errors
+				if !errors.As(err, &errs) {
+					t.Fatalf("expected Errors, got %v", err)
+				}
+
+				var fields []string
+				for _, v := range errs {
+					fields = append(fields, v.Field)
+				}
+				if !slices.Equal(fields, tc.expectedFields) {
+					t.Errorf("expected error fields %v, got %v (%s)", tc.expectedFields, fields, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := expression.Type(), tc.expectedType; got != want {
+				t.Errorf("expected type %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestExpressionNext(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.March, 7, 10, 30, 0, 0, time.UTC) // Friday.
+
+	testcases := map[string]struct {
+		input    string
+		options  []Option
+		location string
+		count    int
+		expected []string
+	}{
+		"at future": {
+			input:    "at(2025-03-08T09:00:00)",
+			count:    3,
+			expected: []string{"2025-03-08T09:00:00Z"},
+		},
+		"at past": {
+			input: "at(2025-03-06T09:00:00)",
+			count: 3,
+		},
+		"rate": {
+			input:    "rate(2 hours)",
+			count:    2,
+			expected: []string{"2025-03-07T12:30:00Z", "2025-03-07T14:30:00Z"},
+		},
+		"cron every 15 minutes": {
+			input:    "cron(0/15 * * * ? *)",
+			count:    3,
+			expected: []string{"2025-03-07T10:45:00Z", "2025-03-07T11:00:00Z", "2025-03-07T11:15:00Z"},
+		},
+		"cron weekdays": {
+			input:    "cron(0 9 ? * MON-FRI *)",
+			count:    2,
+			expected: []string{"2025-03-10T09:00:00Z", "2025-03-11T09:00:00Z"},
+		},
+		"cron last day": {
+			input:    "cron(0 0 L * ? *)",
+			count:    2,
+			expected: []string{"2025-03-31T00:00:00Z", "2025-04-30T00:00:00Z"},
+		},
+		"cron last weekday": {
+			input:    "cron(0 0 LW 5 ? *)",
+			count:    1,
+			expected: []string{"2025-05-30T00:00:00Z"},
+		},
+		"cron nearest weekday": {
+			input:    "cron(0 0 1W 3 ? 2026)",
+			count:    1,
+			expected: []string{"2026-03-02T00:00:00Z"},
+		},
+		"cron second tuesday": {
+			input:    "cron(0 0 ? * TUE#2 *)",
+			count:    2,
+			expected: []string{"2025-03-11T00:00:00Z", "2025-04-08T00:00:00Z"},
+		},
+		"cron last friday": {
+			input:    "cron(0 0 ? * 6L *)",
+			count:    1,
+			expected: []string{"2025-03-28T00:00:00Z"},
+		},
+		"cron year": {
+			input:    "cron(0 0 1 1 ? 2027)",
+			count:    3,
+			expected: []string{"2027-01-01T00:00:00Z"},
+		},
+		"cron seconds": {
+			input:    "cron(0/20 31 10 * * ? *)",
+			options:  []Option{WithCronSeconds()},
+			count:    4,
+			expected: []string{"2025-03-07T10:31:00Z", "2025-03-07T10:31:20Z", "2025-03-07T10:31:40Z", "2025-03-08T10:31:00Z"},
+		},
+		"cron timezone": {
+			input:    "cron(0 9 * * ? *)",
+			location: "America/New_York",
+			count:    3,
+			expected: []string{"2025-03-07T09:00:00-05:00", "2025-03-08T09:00:00-05:00", "2025-03-09T09:00:00-04:00"},
+		},
+		"cron skips nonexistent time": {
+			input:    "cron(30 2 * * ? *)",
+			location: "America/New_York",
+			count:    2,
+			expected: []string{"2025-03-08T02:30:00-05:00", "2025-03-10T02:30:00-04:00"},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Parse(tc.input, tc.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			loc := time.UTC
+			if tc.location != "" {
+				loc, err = time.LoadLocation(tc.location)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var got []string
+			for _, v := range expression.Next(start, loc, tc.count) {
+				got = append(got, v.Format(time.RFC3339))
+			}
+
+			if !slices.Equal(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
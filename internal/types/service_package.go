@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,6 +17,20 @@ import (
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	TagsAll             bool   // Whether a data source or ephemeral resource sets a computed `tags_all` attribute, including provider default_tags
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+// The identity schema comprises the listed attributes, in order.
+// When importing by identity, the first attribute's value is used as the import ID.
+type ServicePackageResourceIdentity struct {
+	Attributes []IdentityAttribute
+}
+
+// IdentityAttribute represents a single attribute of a resource's identity schema.
+type IdentityAttribute struct {
+	Name     string // The attribute name, which must also be a top-level string attribute in the resource schema
+	Optional bool   // Whether the attribute may be omitted when importing by identity
 }
 
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
@@ -24,6 +39,7 @@ type ServicePackageEphemeralResource struct {
 	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Tags     *ServicePackageResourceTags
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -42,6 +58,23 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
+}
+
+// ServicePackageListResource represents a list resource implemented by a service package.
+// A list resource enumerates the existing remote objects of the Terraform Plugin SDK resource type with the same type name.
+type ServicePackageListResource struct {
+	Factory  func() ListResourceForSDK
+	TypeName string
+	Name     string
+}
+
+// ListResourceForSDK is implemented by list resources for Terraform Plugin SDK resources.
+// The resource's and its identity's schemas are those of the registered SDK resource, which is passed to SetResource.
+type ListResourceForSDK interface {
+	list.ListResourceWithConfigure
+	list.ListResourceWithRawV5Schemas
+	SetResource(*schema.Resource)
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -60,4 +93,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
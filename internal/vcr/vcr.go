@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package vcr reports whether acceptance tests are recording or replaying AWS API interactions.
+// It has no dependencies so that low-level packages, such as waiters, can change their behavior when replaying.
+package vcr
+
+import (
+	"os"
+)
+
+const (
+	EnvVarMode = "VCR_MODE"
+	EnvVarPath = "VCR_PATH"
+)
+
+const (
+	ModeRecording = "RECORDING"
+	ModeReplaying = "REPLAYING"
+)
+
+// IsEnabled returns whether VCR is enabled.
+func IsEnabled() bool {
+	return os.Getenv(EnvVarMode) != "" && os.Getenv(EnvVarPath) != ""
+}
+
+// IsReplaying returns whether VCR is replaying recorded interactions.
+// Recorded interactions complete immediately, so there is no need to wait between requests.
+func IsReplaying() bool {
+	return IsEnabled() && os.Getenv(EnvVarMode) == ModeReplaying
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func TestIsReplaying(t *testing.T) {
+	testCases := map[string]struct {
+		mode        string
+		path        string
+		wantEnabled bool
+		wantReplay  bool
+	}{
+		"disabled": {},
+		"no path": {
+			mode: vcr.ModeReplaying,
+		},
+		"recording": {
+			mode:        vcr.ModeRecording,
+			path:        "/tmp",
+			wantEnabled: true,
+		},
+		"replaying": {
+			mode:        vcr.ModeReplaying,
+			path:        "/tmp",
+			wantEnabled: true,
+			wantReplay:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(vcr.EnvVarMode, testCase.mode)
+			t.Setenv(vcr.EnvVarPath, testCase.path)
+
+			if got, want := vcr.IsEnabled(), testCase.wantEnabled; got != want {
+				t.Errorf("IsEnabled() = %t, want %t", got, want)
+			}
+			if got, want := vcr.IsReplaying(), testCase.wantReplay; got != want {
+				t.Errorf("IsReplaying() = %t, want %t", got, want)
+			}
+		})
+	}
+}
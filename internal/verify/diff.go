@@ -5,11 +5,13 @@ package verify
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -42,6 +44,10 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if err := tagPolicyError(ctx, meta.(*conns.AWSClient), defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -103,6 +109,26 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// tagPolicyError returns an error describing any error severity violations of the provider's tag policy by the specified tags.
+// CustomizeDiff cannot return warnings, so warning severity violations are recorded in the Context and reported with the plan.
+func tagPolicyError(ctx context.Context, meta *conns.AWSClient, tags tftags.KeyValueTags) error {
+	var typeName string
+	if inContext, ok := conns.FromContext(ctx); ok {
+		typeName = inContext.TypeName
+	}
+
+	var errs []error
+	for _, v := range meta.TagPolicyConfig(ctx).Evaluate(tags) {
+		if v.IsError() {
+			errs = append(errs, fmt.Errorf("tag policy violation (%s): %s", typeName, v))
+		} else {
+			sdkdiag.AppendContextWarningf(ctx, "tag policy violation (%s): %s", typeName, v)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
@@ -4,8 +4,17 @@
 package verify
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentRoundedTime(t *testing.T) {
@@ -60,3 +69,115 @@ func TestSuppressEquivalentRoundedTime(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTagsDiff_tagPolicy(t *testing.T) {
+	t.Parallel()
+
+	meta := &conns.AWSClient{}
+	conns.SetTagPolicyConfig(meta, &tftags.PolicyConfig{
+		Rules: []tftags.PolicyRule{
+			{
+				Key: "owner",
+			},
+			{
+				AllowedValues: []string{"dev", "prod"},
+				Key:           "environment",
+				Severity:      tftags.PolicySeverityWarning,
+			},
+		},
+	})
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetTagsDiff,
+	}
+
+	testCases := []struct {
+		name         string
+		tags         map[string]string
+		wantErr      string
+		wantWarnings []string
+	}{
+		{
+			name: "compliant",
+			tags: map[string]string{
+				"environment": "prod",
+				"owner":       "finops",
+			},
+		},
+		{
+			name: "warning",
+			tags: map[string]string{
+				"environment": "test",
+				"owner":       "finops",
+			},
+			wantWarnings: []string{`tag policy violation (): tag "environment" has value "test", expected one of [dev, prod]`},
+		},
+		{
+			name: "error and warning",
+			tags: map[string]string{
+				"environment": "test",
+			},
+			wantErr:      `tag policy violation (): tag "owner" is required`,
+			wantWarnings: []string{`tag policy violation (): tag "environment" has value "test", expected one of [dev, prod]`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, warnings := sdkdiag.NewWarningsContext(context.Background())
+
+			config := make(map[string]any)
+			rawTags := make(map[string]cty.Value)
+			for k, v := range testCase.tags {
+				config[k] = v
+				rawTags[k] = cty.StringVal(v)
+			}
+
+			state := &terraform.InstanceState{
+				RawPlan: cty.ObjectVal(map[string]cty.Value{
+					"id":       cty.UnknownVal(cty.String),
+					"tags":     cty.MapVal(rawTags),
+					"tags_all": cty.UnknownVal(cty.Map(cty.String)),
+				}),
+			}
+
+			_, err := r.SimpleDiff(ctx, state, terraform.NewResourceConfigRaw(map[string]any{"tags": config}), meta)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error = %v, want %q", err, testCase.wantErr)
+			}
+
+			var got []string
+			for _, v := range warnings() {
+				got = append(got, v.Summary)
+			}
+
+			if len(got) != len(testCase.wantWarnings) {
+				t.Fatalf("warnings = %q, want %q", got, testCase.wantWarnings)
+			}
+			for i, want := range testCase.wantWarnings {
+				if got[i] != want {
+					t.Errorf("warnings[%d] = %q, want %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
@@ -466,6 +467,37 @@ func ValidDuration(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidScheduleExpression returns a SchemaValidateFunc which tests if the provided value
+// is a valid at(...), cron(...) or rate(...) schedule expression, restricted by any options.
+// Empty values are skipped.
+func ValidScheduleExpression(options ...schedule.Option) schema.SchemaValidateFunc {
+	return func(v any, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		if value == "" {
+			return ws, errors
+		}
+
+		if _, err := schedule.Parse(value, options...); err != nil {
+			fieldErrs, ok := errs.As[schedule.Errors](err)
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %w", k, value, err))
+				return ws, errors
+			}
+
+			for _, err := range fieldErrs {
+				errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %w", k, value, err))
+			}
+		}
+
+		return ws, errors
+	}
+}
+
 // FloatGreaterThan returns a SchemaValidateFunc which tests if the provided value
 // is of type float and is greater than threshold.
 func FloatGreaterThan(threshold float64) schema.SchemaValidateFunc {
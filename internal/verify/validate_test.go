@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -939,3 +940,36 @@ func TestMapKeysAre(t *testing.T) {
 		})
 	}
 }
+
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validate := ValidScheduleExpression(schedule.WithTypes(schedule.TypeCron, schedule.TypeRate))
+
+	validExpressions := []string{
+		"",
+		"rate(1 hour)",
+		"rate(15 minutes)",
+		"cron(0 12 ? * MON-FRI *)",
+		"cron(0/5 8-17 L * ? 2025)",
+	}
+	for _, v := range validExpressions {
+		_, errors := validate(v, "schedule_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid schedule expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := map[string]int{
+		"at(2025-01-01T00:00:00)": 1,
+		"rate(1 hours)":           1,
+		"cron(0 12 * * ?)":        1,
+		"cron(60 24 * * MON *)":   3,
+	}
+	for v, n := range invalidExpressions {
+		_, errors := validate(v, "schedule_expression")
+		if len(errors) != n {
+			t.Fatalf("%q should be an invalid schedule expression with %d errors: %q", v, n, errors)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
@@ -44,6 +45,8 @@ func main() {
 		serveOpts...,
 	)
 
+	tracing.Shutdown()
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -238,6 +238,10 @@ func (sr ServiceRecord) EndpointRegionOverrides() map[string]string {
 	return nil
 }
 
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr.service.IsGlobal
+}
+
 func (sr ServiceRecord) Note() string {
 	return sr.service.Note
 }
@@ -343,6 +347,7 @@ type Service struct {
 	Exclude                       bool     `hcl:"exclude,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	Note                          string   `hcl:"note,optional"`
 }
 
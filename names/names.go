@@ -166,6 +166,7 @@ type serviceDatum struct {
 	aliases           []string
 	brand             string
 	humanFriendly     string
+	isGlobal          bool
 	providerNameUpper string
 }
 
@@ -204,6 +205,7 @@ func readHCLIntoServiceData() error {
 		sd := serviceDatum{
 			brand:             l.Brand(),
 			humanFriendly:     l.HumanFriendly(),
+			isGlobal:          l.IsGlobal(),
 			providerNameUpper: l.ProviderNameUpper(),
 		}
 
@@ -290,3 +292,13 @@ func HumanFriendly(service string) (string, error) {
 
 	return "", fmt.Errorf("no service data found for %s", service)
 }
+
+// IsGlobal returns whether the specified service's resources are global (not Region-scoped).
+// Resources in global services do not support the per-resource `region` argument.
+func IsGlobal(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.isGlobal
+	}
+
+	return false
+}
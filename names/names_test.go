@@ -388,3 +388,54 @@ func TestFullHumanFriendly(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGlobal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			TestName: IAM,
+			Input:    IAM,
+			Expected: true,
+		},
+		{
+			TestName: Route53,
+			Input:    Route53,
+			Expected: true,
+		},
+		{
+			TestName: CostOptimizationHub,
+			Input:    CostOptimizationHub,
+			Expected: true,
+		},
+		{
+			TestName: EC2,
+			Input:    EC2,
+			Expected: false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IsGlobal(testCase.Input), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}